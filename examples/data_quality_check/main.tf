@@ -97,6 +97,18 @@ resource "galaxy_data_quality_check" "example" {
   depends_on  = [galaxy_role_privilege_grant.dq_check]
 }
 
+# Run the checks on the employees table every morning at 06:00 New York time
+resource "galaxy_data_quality_schedule" "example" {
+  catalog_id      = galaxy_postgresql_catalog.test.catalog_id
+  schema_id       = "anu_test"
+  table_id        = "employees"
+  cluster_id      = galaxy_cluster.test.cluster_id
+  role_id         = galaxy_role.dq_check.role_id
+  cron_expression = "0 6 * * *"
+  timezone        = "America/New_York"
+  depends_on      = [galaxy_data_quality_check.example]
+}
+
 # Read back the data quality check via data source
 data "galaxy_data_quality_check" "example" {
   data_quality_check_id = galaxy_data_quality_check.example.data_quality_check_id
@@ -109,3 +121,7 @@ output "data_quality_check_id" {
 output "data_quality_check_name" {
   value = data.galaxy_data_quality_check.example.name
 }
+
+output "data_quality_next_execution" {
+  value = galaxy_data_quality_schedule.example.next_execution
+}
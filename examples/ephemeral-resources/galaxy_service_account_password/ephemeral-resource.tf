@@ -0,0 +1,19 @@
+# Mint a password for this run only; it is deleted when Terraform finishes.
+ephemeral "galaxy_service_account_password" "ci" {
+  service_account_id = galaxy_service_account.example.service_account_id
+  description        = "CI run password"
+}
+
+# Mint a password that outlives the run and hand it to a secrets manager
+# through a write-only argument, so it never lands in state.
+ephemeral "galaxy_service_account_password" "app" {
+  service_account_id = galaxy_service_account.example.service_account_id
+  description        = "Application password"
+  revoke_on_close    = false
+}
+
+resource "aws_secretsmanager_secret_version" "app" {
+  secret_id                = aws_secretsmanager_secret.app.id
+  secret_string_wo         = ephemeral.galaxy_service_account_password.app.password
+  secret_string_wo_version = 1
+}
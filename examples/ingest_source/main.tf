@@ -0,0 +1,124 @@
+terraform {
+  required_providers {
+    galaxy = {
+      source = "starburstdata/galaxy"
+    }
+  }
+}
+
+provider "galaxy" {
+  # Credentials from environment variables
+}
+
+# Use TEST_SUFFIX environment variable for unique naming
+locals {
+  test_suffix = var.test_suffix != "" ? var.test_suffix : substr(replace(uuid(), "[^0-9]", ""), 0, 6)
+}
+
+variable "test_suffix" {
+  description = "Suffix to append to resource names for testing"
+  type        = string
+  default     = ""
+}
+
+variable "ingest_role_arn" {
+  description = "Cross-account IAM role Galaxy assumes to read the landing bucket"
+  type        = string
+}
+
+variable "kafka_api_key" {
+  description = "Kafka SASL username"
+  type        = string
+  sensitive   = true
+}
+
+variable "kafka_api_secret" {
+  description = "Kafka SASL password"
+  type        = string
+  sensitive   = true
+}
+
+# Stream files landing under events/ in an S3 bucket
+resource "galaxy_file_ingest_source" "landing" {
+  name        = "landing${local.test_suffix}"
+  description = "Event files dropped by the upstream exporter"
+  bucket      = "acme-landing-zone"
+  prefix      = "events/"
+  role_arn    = var.ingest_role_arn
+}
+
+# Stream topics from a Kafka cluster authenticated with SASL/SCRAM over TLS
+resource "galaxy_kafka_ingest_source" "events" {
+  name                = "events${local.test_suffix}"
+  description         = "Production event bus"
+  kafka_brokers       = ["b-1.kafka.example.com:9096", "b-2.kafka.example.com:9096"]
+  authentication_type = "SASL_SCRAM_SHA_512"
+  tls_enabled         = true
+  sasl_username       = var.kafka_api_key
+  sasl_password       = var.kafka_api_secret
+}
+
+variable "target_catalog_id" {
+  description = "ID of the galaxy_s3_catalog or galaxy_gcs_catalog that receives the ingested data"
+  type        = string
+}
+
+# Land JSON files from the landing bucket in raw.events
+resource "galaxy_ingest_stream" "landing_events" {
+  name                  = "landing_events${local.test_suffix}"
+  file_ingest_source_id = galaxy_file_ingest_source.landing.file_ingest_source_id
+  file_prefix           = "json/"
+  catalog_id            = var.target_catalog_id
+  schema_name           = "raw"
+  table_name            = "landing_events"
+  message_format        = "JSON"
+}
+
+# Decode Avro messages from the orders topic through a schema registry; keep the stream stopped
+resource "galaxy_ingest_stream" "orders" {
+  name                     = "orders${local.test_suffix}"
+  kafka_ingest_source_id   = galaxy_kafka_ingest_source.events.kafka_ingest_source_id
+  topic                    = "orders"
+  catalog_id               = var.target_catalog_id
+  schema_name              = "raw"
+  table_name               = "orders"
+  message_format           = "AVRO"
+  schema_registry_url      = "https://schema-registry.example.com"
+  schema_registry_username = var.kafka_api_key
+  schema_registry_password = var.kafka_api_secret
+  state                    = "STOPPED"
+}
+
+# Read the sources back through the singular data sources
+data "galaxy_file_ingest_source" "landing" {
+  file_ingest_source_id = galaxy_file_ingest_source.landing.file_ingest_source_id
+}
+
+data "galaxy_kafka_ingest_source" "events" {
+  kafka_ingest_source_id = galaxy_kafka_ingest_source.events.kafka_ingest_source_id
+}
+
+# List all ingest sources
+data "galaxy_file_ingest_sources" "all" {
+  depends_on = [galaxy_file_ingest_source.landing]
+}
+
+data "galaxy_kafka_ingest_sources" "all" {
+  depends_on = [galaxy_kafka_ingest_source.events]
+}
+
+output "landing_bucket" {
+  value = data.galaxy_file_ingest_source.landing.bucket
+}
+
+output "events_brokers" {
+  value = data.galaxy_kafka_ingest_source.events.kafka_brokers
+}
+
+output "ingest_source_count" {
+  value = length(data.galaxy_file_ingest_sources.all.result) + length(data.galaxy_kafka_ingest_sources.all.result)
+}
+
+output "orders_stream_state" {
+  value = galaxy_ingest_stream.orders.state
+}
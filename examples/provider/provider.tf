@@ -15,6 +15,28 @@ provider "galaxy" {
   # client_id     = "your-client-id"
   # client_secret = "your-client-secret"
   # domain        = "https://your-account.galaxy.starburst.io"
+
+  # Or read from a named profile in ~/.galaxy/credentials (also GALAXY_PROFILE)
+  # profile = "prod"
+
+  # CI pipelines can authenticate without a client secret, using either a pre-issued
+  # token (GALAXY_ACCESS_TOKEN) or an OIDC ID token exchanged with client_id (GALAXY_OIDC_TOKEN_FILE)
+  # access_token    = var.galaxy_access_token
+  # oidc_token_file = "/tmp/oidc-token"
+
+  # Optional tuning for large accounts (also GALAXY_MAX_RETRIES, GALAXY_REQUEST_TIMEOUT,
+  # GALAXY_RATE_LIMIT_PER_SECOND and GALAXY_CLUSTER_RATE_LIMIT_PER_SECOND)
+  # max_retries                   = 8
+  # request_timeout               = "90s"
+  # rate_limit_per_second         = 3
+  # cluster_rate_limit_per_second = 0.5
+
+  # Optional network settings for corporate proxies (also GALAXY_PROXY_URL, GALAXY_CA_CERT_FILE,
+  # GALAXY_CLIENT_CERT_FILE and GALAXY_CLIENT_KEY_FILE)
+  # proxy_url        = "http://proxy.example.com:3128"
+  # ca_cert_file     = "/etc/ssl/certs/corporate-root.pem"
+  # client_cert_file = "/etc/galaxy/client.pem"
+  # client_key_file  = "/etc/galaxy/client-key.pem"
 }
 
 # Create a Starburst Galaxy cluster
@@ -32,4 +54,10 @@ resource "galaxy_cluster" "example" {
 
   # Associate with catalogs (need to create catalogs first)
   catalog_refs = []
+
+  # Large warp speed clusters can take longer than the 10 minute default to start
+  # timeouts {
+  #   create = "30m"
+  #   update = "30m"
+  # }
 }
\ No newline at end of file
@@ -0,0 +1,2 @@
+# Cluster catalog attachment can be imported by specifying the cluster ID and the catalog ID, separated by a slash.
+terraform import galaxy_cluster_catalog_attachment.example <cluster_id>/<catalog_id>
@@ -0,0 +1,2 @@
+# Data quality schedule can be imported by specifying the catalog ID, schema and table separated by slashes.
+terraform import galaxy_data_quality_schedule.example <catalog_id>/<schema_id>/<table_id>
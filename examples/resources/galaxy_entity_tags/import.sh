@@ -0,0 +1,3 @@
+# Entity tags can be imported by specifying the entity IDs separated by slashes.
+# The entity kind is inferred from the number of IDs: catalog, schema, table or column.
+terraform import galaxy_entity_tags.example <catalog_id>/<schema_id>/<table_id>
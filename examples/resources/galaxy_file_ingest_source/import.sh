@@ -0,0 +1,2 @@
+# File ingest source can be imported by specifying the file ingest source ID.
+terraform import galaxy_file_ingest_source.example <file_ingest_source_id>
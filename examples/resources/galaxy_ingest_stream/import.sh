@@ -0,0 +1,2 @@
+# Ingest stream can be imported by specifying the ingest stream ID.
+terraform import galaxy_ingest_stream.example <ingest_stream_id>
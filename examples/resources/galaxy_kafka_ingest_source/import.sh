@@ -0,0 +1,2 @@
+# Kafka ingest source can be imported by specifying the Kafka ingest source ID.
+terraform import galaxy_kafka_ingest_source.example <kafka_ingest_source_id>
@@ -0,0 +1,2 @@
+# Private link can be imported by specifying the private link ID.
+terraform import galaxy_privatelink.example <privatelink_id>
@@ -0,0 +1,2 @@
+# SQL job can be imported by specifying the SQL job ID.
+terraform import galaxy_sql_job.example <sql_job_id>
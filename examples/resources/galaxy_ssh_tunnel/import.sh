@@ -0,0 +1,2 @@
+# SSH tunnel can be imported by specifying the SSH tunnel ID.
+terraform import galaxy_ssh_tunnel.example <ssh_tunnel_id>
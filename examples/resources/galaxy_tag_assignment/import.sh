@@ -0,0 +1,3 @@
+# Tag assignment can be imported by specifying the tag ID followed by the entity IDs, separated by slashes.
+# The entity kind is inferred from the number of IDs: catalog, schema, table or column.
+terraform import galaxy_tag_assignment.example <tag_id>/<catalog_id>/<schema_id>/<table_id>/<column_id>
@@ -0,0 +1,2 @@
+# Usage example can be imported by specifying the data product ID and the usage example ID.
+terraform import galaxy_usage_example.example <data_product_id>/<usage_example_id>
@@ -0,0 +1,3 @@
+# User can be imported by specifying the user ID or the user's email address.
+terraform import galaxy_user.example <user_id>
+terraform import galaxy_user.example jane.doe@example.com
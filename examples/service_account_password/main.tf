@@ -33,6 +33,15 @@ resource "galaxy_service_account_password" "rotation_password" {
   description        = "Rotation password for zero-downtime updates"
 }
 
+# Password rotated every 90 days. The previous password stays valid for
+# 24 hours after each rotation so clients can pick up the new one.
+resource "galaxy_service_account_password" "rotating_password" {
+  service_account_id     = galaxy_service_account.example.service_account_id
+  description            = "Automatically rotated password"
+  rotation_days          = 90
+  rotation_overlap_hours = 24
+}
+
 # Data source to read service account (includes password info)
 data "galaxy_service_account" "example" {
   depends_on = [
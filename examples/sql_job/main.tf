@@ -0,0 +1,78 @@
+terraform {
+  required_providers {
+    galaxy = {
+      source = "starburstdata/galaxy"
+    }
+  }
+}
+
+provider "galaxy" {
+  # Credentials from environment variables
+}
+
+# Use TEST_SUFFIX environment variable for unique naming
+locals {
+  test_suffix = var.test_suffix != "" ? var.test_suffix : substr(replace(uuid(), "[^0-9]", ""), 0, 6)
+}
+
+variable "test_suffix" {
+  description = "Suffix to append to resource names for testing"
+  type        = string
+  default     = ""
+}
+
+resource "galaxy_cluster" "jobs" {
+  name                    = "jobs${local.test_suffix}"
+  cloud_region_id         = "aws-us-east1"
+  min_workers             = 1
+  max_workers             = 1
+  idle_stop_minutes       = 15
+  private_link_cluster    = false
+  result_cache_enabled    = false
+  warp_resiliency_enabled = false
+  catalog_refs            = []
+}
+
+resource "galaxy_role" "jobs" {
+  role_name              = "jobrunner${local.test_suffix}"
+  role_description       = "Role used by scheduled SQL jobs"
+  grant_to_creating_role = true
+}
+
+# Run a nightly query at 03:00 Berlin time
+resource "galaxy_sql_job" "nightly" {
+  name            = "nightly${local.test_suffix}"
+  description     = "Nightly health check query"
+  query           = "SELECT count(*) FROM system.runtime.nodes"
+  cluster_id      = galaxy_cluster.jobs.cluster_id
+  role_id         = galaxy_role.jobs.role_id
+  cron_expression = "0 3 * * *"
+  timezone        = "Europe/Berlin"
+  enabled         = true
+}
+
+# Run history for the job
+data "galaxy_sql_job_history" "nightly" {
+  sql_job_id = galaxy_sql_job.nightly.sql_job_id
+}
+
+# All SQL jobs with their last run status. include_status costs one API call per job.
+data "galaxy_sql_jobs" "all" {
+  include_status = true
+
+  depends_on = [galaxy_sql_job.nightly]
+}
+
+output "nightly_next_execution" {
+  value = galaxy_sql_job.nightly.next_execution
+}
+
+output "nightly_last_run_status" {
+  value = data.galaxy_sql_job_history.nightly.last_run_status
+}
+
+output "failed_jobs" {
+  value = [
+    for job in data.galaxy_sql_jobs.all.result : job.name if job.last_run_status == "FAILED"
+  ]
+}
@@ -0,0 +1,73 @@
+terraform {
+  required_providers {
+    galaxy = {
+      source = "starburstdata/galaxy"
+    }
+  }
+}
+
+provider "galaxy" {
+  # Credentials from environment variables
+}
+
+# Use TEST_SUFFIX environment variable for unique naming
+locals {
+  test_suffix = var.test_suffix != "" ? var.test_suffix : substr(replace(uuid(), "[^0-9]", ""), 0, 6)
+}
+
+variable "test_suffix" {
+  description = "Suffix to append to resource names for testing"
+  type        = string
+  default     = ""
+}
+
+# Create an SSH tunnel through a bastion host. Galaxy generates the key pair;
+# the public key must be added to the bastion user's authorized_keys.
+resource "galaxy_ssh_tunnel" "bastion" {
+  name            = "bastion${local.test_suffix}"
+  description     = "Bastion host in the data VPC"
+  cloud_region_id = "aws-us-east1"
+  ssh_tunnel_host = "bastion.example.com"
+  ssh_tunnel_port = 22
+  ssh_tunnel_user = "galaxy"
+}
+
+# Route a PostgreSQL catalog through the tunnel
+resource "galaxy_postgresql_catalog" "private" {
+  name          = "pgprivate${local.test_suffix}"
+  endpoint      = "postgres.internal.example.com"
+  port          = 5432
+  database_name = "analytics"
+  username      = "galaxy"
+  password      = var.postgresql_password
+  read_only     = true
+  ssh_tunnel_id = galaxy_ssh_tunnel.bastion.ssh_tunnel_id
+}
+
+variable "postgresql_password" {
+  description = "Password for the PostgreSQL catalog"
+  type        = string
+  sensitive   = true
+  default     = ""
+}
+
+# Data source to read the tunnel
+data "galaxy_ssh_tunnel" "bastion" {
+  ssh_tunnel_id = galaxy_ssh_tunnel.bastion.ssh_tunnel_id
+}
+
+# List all SSH tunnels
+data "galaxy_ssh_tunnels" "all" {
+  depends_on = [galaxy_ssh_tunnel.bastion]
+}
+
+# Feed this into the bastion host's authorized_keys
+output "bastion_public_key" {
+  value = galaxy_ssh_tunnel.bastion.public_key
+}
+
+output "all_ssh_tunnel_names" {
+  value = [
+    for tunnel in data.galaxy_ssh_tunnels.all.result : tunnel.name
+  ]
+}
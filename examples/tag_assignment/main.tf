@@ -0,0 +1,83 @@
+terraform {
+  required_providers {
+    galaxy = {
+      source = "starburstdata/galaxy"
+    }
+  }
+}
+
+provider "galaxy" {
+  # Credentials from environment variables
+}
+
+# Use TEST_SUFFIX environment variable for unique naming
+locals {
+  test_suffix = var.test_suffix != "" ? var.test_suffix : substr(replace(uuid(), "[^0-9]", ""), 0, 6)
+}
+
+variable "test_suffix" {
+  description = "Suffix to append to resource names for testing"
+  type        = string
+  default     = ""
+}
+
+variable "catalog_id" {
+  description = "ID of an existing catalog to tag"
+  type        = string
+}
+
+variable "schema_id" {
+  description = "ID of a schema in the catalog"
+  type        = string
+}
+
+variable "table_id" {
+  description = "ID of a table in the schema"
+  type        = string
+}
+
+variable "column_id" {
+  description = "ID of a column in the table"
+  type        = string
+}
+
+resource "galaxy_tag" "pii" {
+  name        = "pii${local.test_suffix}"
+  description = "Personally Identifiable Information - requires special handling"
+  color       = "#FF0000"
+}
+
+resource "galaxy_tag" "finance" {
+  name        = "finance${local.test_suffix}"
+  description = "Owned by the finance team"
+  color       = "#FFFF00"
+}
+
+resource "galaxy_tag" "gold" {
+  name        = "gold${local.test_suffix}"
+  description = "Curated, production-quality data"
+  color       = "#FFD700"
+}
+
+# Non-authoritative: adds one tag to a column and leaves any other tags on it alone
+resource "galaxy_tag_assignment" "email_pii" {
+  tag_id      = galaxy_tag.pii.tag_id
+  entity_kind = "column"
+  catalog_id  = var.catalog_id
+  schema_id   = var.schema_id
+  table_id    = var.table_id
+  column_id   = var.column_id
+}
+
+# Authoritative: the table carries exactly these tags; anything else is removed
+resource "galaxy_entity_tags" "orders" {
+  entity_kind = "table"
+  catalog_id  = var.catalog_id
+  schema_id   = var.schema_id
+  table_id    = var.table_id
+  tag_ids     = [galaxy_tag.finance.tag_id, galaxy_tag.gold.tag_id]
+}
+
+output "email_pii_tag_name" {
+  value = galaxy_tag_assignment.email_pii.tag_name
+}
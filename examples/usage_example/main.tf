@@ -71,9 +71,24 @@ resource "galaxy_data_product" "test" {
   ]
 }
 
+# Attach a usage example to the data product
+resource "galaxy_usage_example" "test" {
+  data_product_id  = galaxy_data_product.test.data_product_id
+  name             = "Recent orders"
+  description      = "Orders placed in the last seven days"
+  code             = <<-SQL
+    SELECT order_id, customer_id, total
+    FROM orders
+    WHERE order_date > current_date - INTERVAL '7' DAY
+  SQL
+  suggested_prompt = "Which orders were placed this week?"
+}
+
 # List usage examples for the data product
 data "galaxy_usage_example" "test" {
   data_product_id = galaxy_data_product.test.data_product_id
+
+  depends_on = [galaxy_usage_example.test]
 }
 
 output "usage_examples_count" {
@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    galaxy = {
+      source = "starburstdata/galaxy"
+    }
+  }
+}
+
+provider "galaxy" {
+  # Credentials from environment variables
+}
+
+# Use TEST_SUFFIX environment variable for unique naming
+locals {
+  test_suffix = var.test_suffix != "" ? var.test_suffix : substr(replace(uuid(), "[^0-9]", ""), 0, 6)
+}
+
+variable "test_suffix" {
+  description = "Suffix to append to resource names for testing"
+  type        = string
+  default     = ""
+}
+
+resource "galaxy_role" "analyst" {
+  role_name              = "analyst${local.test_suffix}"
+  role_description       = "Analysts onboarded through Terraform"
+  grant_to_creating_role = true
+}
+
+# Invite a user, make analyst their default role and grant it directly
+resource "galaxy_user" "analyst" {
+  email                     = "analyst${local.test_suffix}@example.com"
+  default_role_id           = galaxy_role.analyst.role_id
+  directly_granted_role_ids = [galaxy_role.analyst.role_id]
+
+  # Keep query history and owned objects when the user is removed from Terraform
+  deactivate_on_destroy = true
+}
+
+# Read the user back through the data source
+data "galaxy_user" "analyst" {
+  user_id = galaxy_user.analyst.user_id
+}
+
+output "analyst_user_id" {
+  value = galaxy_user.analyst.user_id
+}
+
+output "analyst_all_roles" {
+  value = [for role in data.galaxy_user.analyst.all_roles : role.role_name]
+}
@@ -12,13 +12,18 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -31,8 +36,11 @@ import (
 // infra-automation/global-stack/src/cloudflare/rateLimits.ts.
 // All CF rules use mitigationTimeout=0, so 429s never carry a meaningful Retry-After;
 // backoff bases are set directly to the CF window durations (10s global, 60s cluster).
+// When a response does carry Retry-After or RateLimit-Remaining/RateLimit-Reset headers,
+// they take precedence over the fixed backoff and slow down the shared limiters.
 const (
 	maxRequestRetries      = 5
+	defaultRequestTimeout  = 30 * time.Second
 	backoffBase            = 10 * time.Second
 	clusterBackoffBase     = 60 * time.Second // matches CF's cluster mitigationTimeout window exactly
 	backoffCap             = 60 * time.Second
@@ -41,6 +49,16 @@ const (
 	clusterRateLimitPerSec = 0.3
 	clusterBurstLimit      = 2 // reduced from 5: parallel tests each have separate limiters; smaller burst limits server-side overflow
 
+	// maxServerRetryDelay bounds a wait requested by Retry-After or RateLimit-Reset.
+	maxServerRetryDelay = 5 * time.Minute
+
+	// rateLimitResetEpochThreshold separates the two RateLimit-Reset formats. The Cloudflare
+	// rules in front of Galaxy follow the IETF draft headers, where the reset is delta seconds;
+	// gateways that set the de facto X-RateLimit-Reset header often send a Unix timestamp
+	// instead. A delta of 10^9 seconds would be over 31 years, while any current timestamp
+	// (September 2001 onwards) exceeds it.
+	rateLimitResetEpochThreshold = 1_000_000_000
+
 	// clusterPathPrefix matches CF's 20/60s cluster rate-limit bucket for v1.
 	// POST /cluster (create, no trailing ID) falls under the global limiter.
 	// Update this constant if v2+ cluster APIs are added.
@@ -54,8 +72,110 @@ const (
 var (
 	sharedLimiter        = rate.NewLimiter(rate.Limit(requestRateLimitPerSec), requestBurstLimit)
 	sharedClusterLimiter = rate.NewLimiter(rate.Limit(clusterRateLimitPerSec), clusterBurstLimit)
+
+	// sharedLimitMu guards the rates explicitly configured for the shared limiters.
+	// When several clients in one process configure different rates, the lowest wins:
+	// they all draw from the same per-IP budget.
+	sharedLimitMu              sync.Mutex
+	configuredRateLimit        float64
+	configuredClusterRateLimit float64
+
+	// adaptedUntil and clusterAdaptedUntil record when a rate lowered from response
+	// headers expires and the limiter returns to its configured rate. Guarded by sharedLimitMu.
+	adaptedUntil        time.Time
+	clusterAdaptedUntil time.Time
 )
 
+// Options holds client settings that can be tuned from the provider configuration.
+// Zero values select the defaults.
+type Options struct {
+	// MaxRetries is the number of times a failed request is retried. Negative disables retries.
+	MaxRetries int
+	// RequestTimeout bounds each HTTP request, including reading the response body.
+	RequestTimeout time.Duration
+	// RateLimitPerSecond is the steady-state request rate shared by all clients in the process.
+	RateLimitPerSecond float64
+	// ClusterRateLimitPerSecond is the rate for cluster endpoints, on top of RateLimitPerSecond.
+	ClusterRateLimitPerSecond float64
+	// Transport carries both token and API requests. Nil selects http.DefaultTransport.
+	Transport http.RoundTripper
+	// AccessToken is a pre-issued Galaxy access token used instead of client credentials.
+	AccessToken string
+	// OIDCTokenFile is the path of an OIDC ID token, e.g. from a CI workload identity, that
+	// is exchanged for a Galaxy access token instead of using a client secret. The file is
+	// read again on every refresh so a rotated token is picked up.
+	OIDCTokenFile string
+	// HTTPTrace logs every request with its status, latency, retry attempt and bodies, with
+	// sensitive fields redacted.
+	HTTPTrace bool
+	// DisableRateLimits exempts the client from the shared rate limiters. It is meant for
+	// tests against a local server, which would otherwise drain the process-wide budget.
+	DisableRateLimits bool
+}
+
+// TransportOptions configures how the client connects to Galaxy. Zero values keep the
+// behavior of http.DefaultTransport, including proxies set through HTTPS_PROXY.
+type TransportOptions struct {
+	// ProxyURL routes all requests through an HTTP, HTTPS or SOCKS5 proxy.
+	ProxyURL string
+	// CACertPEM holds certificates trusted in addition to the system pool, e.g. the root
+	// of a TLS-intercepting proxy.
+	CACertPEM []byte
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+	// ClientCertPEM and ClientKeyPEM hold a certificate presented for mutual TLS.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+}
+
+// NewTransport builds an HTTP transport from opts.
+func NewTransport(opts TransportOptions) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https or socks5", opts.ProxyURL)
+		}
+		if proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: missing host", opts.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	if len(opts.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(opts.CACertPEM) {
+			return nil, errors.New("CA bundle does not contain any PEM-encoded certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(opts.ClientCertPEM) > 0 || len(opts.ClientKeyPEM) > 0 {
+		cert, err := tls.X509KeyPair(opts.ClientCertPEM, opts.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
 type GalaxyClient struct {
 	BaseURL         string
 	ClientID        string
@@ -63,13 +183,37 @@ type GalaxyClient struct {
 	HTTPClient      *http.Client
 	ProviderVersion string
 
+	// maxRetries is the retry budget of each request
+	maxRetries int
+
+	// recoveryBackoff overrides backoffBase between create recovery attempts in tests
+	recoveryBackoff time.Duration
+
+	// trace enables HTTP trace logging, see Options.HTTPTrace
+	trace bool
+
+	// unlimited bypasses the shared rate limiters, see Options.DisableRateLimits
+	unlimited bool
+
 	tokenMu     sync.RWMutex
 	accessToken string
 	tokenExpiry time.Time
 
+	// refreshToken obtains a new access token for the configured authentication mode.
+	// Nil selects the client_credentials grant.
+	refreshToken tokenRefresher
+
 	// roleMu serializes read-modify-write operations on role grants
 	// to prevent concurrent PATCH conflicts (RETRY_SERIALIZABLE errors)
 	roleMu sync.Map // map[string]*sync.Mutex
+
+	// entityTagMu serializes read-modify-write operations on the tag set of
+	// a catalog, schema, table or column, keyed by the entity's tag path
+	entityTagMu sync.Map // map[string]*sync.Mutex
+
+	// clusterMu serializes read-modify-write operations on the catalogs
+	// attached to a cluster
+	clusterMu sync.Map // map[string]*sync.Mutex
 }
 
 type TokenResponse struct {
@@ -78,35 +222,325 @@ type TokenResponse struct {
 	ExpiresIn   int    `json:"expires_in"`
 }
 
-func NewGalaxyClient(baseURL, clientID, clientSecret, providerVersion string) *GalaxyClient {
-	return &GalaxyClient{
+// tokenRefresher obtains a new access token and the time after which it must be refreshed.
+type tokenRefresher func(ctx context.Context) (string, time.Time, error)
+
+const (
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	idTokenType            = "urn:ietf:params:oauth:token-type:id_token"
+	accessTokenType        = "urn:ietf:params:oauth:token-type:access_token"
+
+	// staticTokenRecheck is how often a pre-issued token without an exp claim is re-checked.
+	staticTokenRecheck = time.Hour
+)
+
+// errAccessTokenExpired is returned once a pre-issued access token has expired. Retrying
+// cannot help, so the request fails immediately.
+var errAccessTokenExpired = errors.New("access token expired")
+
+func NewGalaxyClient(baseURL, clientID, clientSecret, providerVersion string, opts Options) *GalaxyClient {
+	maxRetries := maxRequestRetries
+	if opts.MaxRetries > 0 {
+		maxRetries = opts.MaxRetries
+	} else if opts.MaxRetries < 0 {
+		maxRetries = 0
+	}
+
+	timeout := defaultRequestTimeout
+	if opts.RequestTimeout > 0 {
+		timeout = opts.RequestTimeout
+	}
+
+	if !opts.DisableRateLimits {
+		sharedLimitMu.Lock()
+		configureSharedLimiter(sharedLimiter, &configuredRateLimit, opts.RateLimitPerSecond)
+		configureSharedLimiter(sharedClusterLimiter, &configuredClusterRateLimit, opts.ClusterRateLimitPerSecond)
+		sharedLimitMu.Unlock()
+	}
+
+	c := &GalaxyClient{
 		BaseURL:         strings.TrimSuffix(baseURL, "/"),
 		ClientID:        clientID,
 		ClientSecret:    clientSecret,
 		ProviderVersion: providerVersion,
 		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   timeout,
+			Transport: opts.Transport,
 		},
+		maxRetries: maxRetries,
+		trace:      opts.HTTPTrace,
+		unlimited:  opts.DisableRateLimits,
+	}
+
+	switch {
+	case opts.AccessToken != "":
+		c.refreshToken = staticToken(opts.AccessToken)
+	case opts.OIDCTokenFile != "":
+		c.refreshToken = c.oidcTokenExchange(opts.OIDCTokenFile)
+	default:
+		c.refreshToken = c.clientCredentialsToken
+	}
+	return c
+}
+
+// configureSharedLimiter applies an explicitly configured rate to a shared limiter. Once a
+// rate has been configured, only lower rates replace it. Callers must hold sharedLimitMu.
+func configureSharedLimiter(limiter *rate.Limiter, configured *float64, perSecond float64) {
+	if perSecond <= 0 {
+		return
+	}
+	if *configured == 0 || perSecond < *configured {
+		*configured = perSecond
+		limiter.SetLimit(rate.Limit(perSecond))
+	}
+}
+
+// baseRateLimit returns the rate a shared limiter runs at when it is not adapted to response
+// headers. Callers must hold sharedLimitMu.
+func baseRateLimit(cluster bool) rate.Limit {
+	if cluster {
+		if configuredClusterRateLimit > 0 {
+			return rate.Limit(configuredClusterRateLimit)
+		}
+		return rate.Limit(clusterRateLimitPerSec)
+	}
+	if configuredRateLimit > 0 {
+		return rate.Limit(configuredRateLimit)
 	}
+	return rate.Limit(requestRateLimitPerSec)
+}
+
+// sharedLimiterFor returns the limiter that budgets requests to path and its adaptation expiry.
+func sharedLimiterFor(path string) (*rate.Limiter, *time.Time, bool) {
+	if strings.HasPrefix(path, clusterPathPrefix) {
+		return sharedClusterLimiter, &clusterAdaptedUntil, true
+	}
+	return sharedLimiter, &adaptedUntil, false
+}
+
+// waitLimiter waits for a token from limiter. rate.Limiter fails early, with an error that
+// does not wrap the context's, when the wait would outlast the context deadline; that case is
+// reported as context.DeadlineExceeded so callers can recognize it as a timeout.
+func waitLimiter(ctx context.Context, limiter *rate.Limiter) error {
+	err := limiter.Wait(ctx)
+	if err != nil && ctx.Err() == nil {
+		if _, hasDeadline := ctx.Deadline(); hasDeadline {
+			return fmt.Errorf("%w: %s", context.DeadlineExceeded, err)
+		}
+	}
+	return err
+}
+
+// restoreSharedLimiters returns limiters whose adapted rate has expired to their base rate.
+func restoreSharedLimiters(now time.Time) {
+	sharedLimitMu.Lock()
+	defer sharedLimitMu.Unlock()
+	if !adaptedUntil.IsZero() && now.After(adaptedUntil) {
+		sharedLimiter.SetLimitAt(now, baseRateLimit(false))
+		adaptedUntil = time.Time{}
+	}
+	if !clusterAdaptedUntil.IsZero() && now.After(clusterAdaptedUntil) {
+		sharedClusterLimiter.SetLimitAt(now, baseRateLimit(true))
+		clusterAdaptedUntil = time.Time{}
+	}
+}
+
+// adaptSharedLimiter spreads the remaining budget reported by the server over the time until
+// it resets. The limiter is never raised above its base rate, and when the budget is
+// exhausted the limiter's tokens are drained so the next request waits for the reset.
+func adaptSharedLimiter(path string, header http.Header, now time.Time) {
+	remaining, reset, ok := parseRateLimitHeaders(header, now)
+	if !ok {
+		return
+	}
+
+	sharedLimitMu.Lock()
+	defer sharedLimitMu.Unlock()
+	limiter, until, cluster := sharedLimiterFor(path)
+	base := baseRateLimit(cluster)
+
+	if remaining == 0 {
+		limiter.SetLimitAt(now, rate.Limit(1/reset.Seconds()))
+		if tokens := int(limiter.TokensAt(now)); tokens > 0 {
+			limiter.ReserveN(now, tokens)
+		}
+		*until = now.Add(reset)
+		return
+	}
+
+	adapted := rate.Limit(float64(remaining) / reset.Seconds())
+	if adapted >= base {
+		limiter.SetLimitAt(now, base)
+		*until = time.Time{}
+		return
+	}
+	limiter.SetLimitAt(now, adapted)
+	*until = now.Add(reset)
+}
+
+// parseRateLimitHeaders reads the remaining request budget and the time until it resets from
+// RateLimit-Remaining and RateLimit-Reset, or their X- prefixed variants. Reset is read as
+// delta seconds, or as a Unix timestamp from rateLimitResetEpochThreshold upwards.
+func parseRateLimitHeaders(header http.Header, now time.Time) (int, time.Duration, bool) {
+	remainingValue := firstHeader(header, "RateLimit-Remaining", "X-RateLimit-Remaining")
+	resetValue := firstHeader(header, "RateLimit-Reset", "X-RateLimit-Reset")
+	if remainingValue == "" || resetValue == "" {
+		return 0, 0, false
+	}
+
+	remaining, err := strconv.Atoi(remainingValue)
+	if err != nil || remaining < 0 {
+		return 0, 0, false
+	}
+	resetSeconds, err := strconv.ParseInt(resetValue, 10, 64)
+	if err != nil || resetSeconds < 0 {
+		return 0, 0, false
+	}
+
+	reset := time.Duration(resetSeconds) * time.Second
+	if resetSeconds >= rateLimitResetEpochThreshold {
+		reset = time.Unix(resetSeconds, 0).Sub(now)
+	}
+	if reset <= 0 {
+		return 0, 0, false
+	}
+	return remaining, min(reset, maxServerRetryDelay), true
+}
+
+// serverRetryDelay returns how long the server asked the client to wait before retrying: the
+// Retry-After header, given as seconds or an HTTP date, or otherwise the time until an
+// exhausted rate-limit budget resets.
+func serverRetryDelay(header http.Header, now time.Time) (time.Duration, bool) {
+	if value := header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, maxServerRetryDelay), true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return min(max(date.Sub(now), 0), maxServerRetryDelay), true
+		}
+	}
+	if remaining, reset, ok := parseRateLimitHeaders(header, now); ok && remaining == 0 {
+		return reset, true
+	}
+	return 0, false
+}
+
+func firstHeader(header http.Header, names ...string) string {
+	for _, name := range names {
+		if value := strings.TrimSpace(header.Get(name)); value != "" {
+			return value
+		}
+	}
+	return ""
 }
 
 func (c *GalaxyClient) getAccessToken(ctx context.Context) error {
-	credentials := base64.StdEncoding.EncodeToString([]byte(c.ClientID + ":" + c.ClientSecret))
+	refresh := c.refreshToken
+	if refresh == nil {
+		refresh = c.clientCredentialsToken
+	}
+
+	token, expiry, err := refresh(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.tokenMu.Lock()
+	c.accessToken = token
+	c.tokenExpiry = expiry
+	c.tokenMu.Unlock()
+
+	return nil
+}
 
+// clientCredentialsToken obtains a token with the OAuth client_credentials grant.
+func (c *GalaxyClient) clientCredentialsToken(ctx context.Context) (string, time.Time, error) {
 	data := url.Values{}
 	data.Set("grant_type", "client_credentials")
 
+	credentials := base64.StdEncoding.EncodeToString([]byte(c.ClientID + ":" + c.ClientSecret))
+	return c.requestToken(ctx, data, "Basic "+credentials)
+}
+
+// oidcTokenExchange returns a tokenRefresher that exchanges the OIDC ID token in tokenFile for
+// a Galaxy access token (RFC 8693). The client ID identifies the Galaxy client that trusts the
+// token's issuer; no client secret is sent.
+func (c *GalaxyClient) oidcTokenExchange(tokenFile string) tokenRefresher {
+	return func(ctx context.Context) (string, time.Time, error) {
+		idToken, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("failed to read OIDC token file: %w", err)
+		}
+
+		data := url.Values{}
+		data.Set("grant_type", tokenExchangeGrantType)
+		data.Set("subject_token", strings.TrimSpace(string(idToken)))
+		data.Set("subject_token_type", idTokenType)
+		data.Set("requested_token_type", accessTokenType)
+		if c.ClientID != "" {
+			data.Set("client_id", c.ClientID)
+		}
+		return c.requestToken(ctx, data, "")
+	}
+}
+
+// staticToken returns a tokenRefresher for a pre-issued access token. The token cannot be
+// renewed, so once its exp claim has passed the refresher fails with an error asking for a
+// new one.
+func staticToken(token string) tokenRefresher {
+	return func(ctx context.Context) (string, time.Time, error) {
+		now := time.Now()
+		exp, ok := jwtExpiry(token)
+		if !ok {
+			return token, now.Add(staticTokenRecheck), nil
+		}
+		if !now.Before(exp) {
+			return "", time.Time{}, fmt.Errorf("%w at %s; provide a new access token", errAccessTokenExpired, exp.Format(time.RFC3339))
+		}
+		return token, exp, nil
+	}
+}
+
+// jwtExpiry returns the exp claim of a JWT without verifying its signature.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Exp, 0), true
+}
+
+// requestToken posts a token request to the Galaxy OAuth endpoint. authorization is sent as
+// the Authorization header when set.
+func (c *GalaxyClient) requestToken(ctx context.Context, data url.Values, authorization string) (string, time.Time, error) {
 	req, err := http.NewRequestWithContext(ctx, "POST", c.BaseURL+"/oauth/v2/token", strings.NewReader(data.Encode()))
 	if err != nil {
-		return fmt.Errorf("failed to create token request: %w", err)
+		return "", time.Time{}, fmt.Errorf("failed to create token request: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Basic "+credentials)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
+	start := time.Now()
 	resp, err := c.HTTPClient.Do(req)
+	if c.trace {
+		// Token requests and responses are credentials in their entirety; never log the bodies.
+		c.traceRequest(ctx, http.MethodPost, "/oauth/v2/token", 0, nil, resp, nil, err, time.Since(start))
+	}
 	if err != nil {
-		return fmt.Errorf("failed to request token: %w", err)
+		return "", time.Time{}, fmt.Errorf("failed to request token: %w", err)
 	}
 	defer func() {
 		if closeErr := resp.Body.Close(); closeErr != nil {
@@ -119,20 +553,15 @@ func (c *GalaxyClient) getAccessToken(ctx context.Context) error {
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(body))
+		return "", time.Time{}, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(body))
 	}
 
 	var tokenResp TokenResponse
 	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		return fmt.Errorf("failed to decode token response: %w", err)
+		return "", time.Time{}, fmt.Errorf("failed to decode token response: %w", err)
 	}
 
-	c.tokenMu.Lock()
-	c.accessToken = tokenResp.AccessToken
-	c.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn-60) * time.Second) // Subtract 60s for buffer
-	c.tokenMu.Unlock()
-
-	return nil
+	return tokenResp.AccessToken, time.Now().Add(time.Duration(tokenResp.ExpiresIn-60) * time.Second), nil // Subtract 60s for buffer
 }
 
 func (c *GalaxyClient) ensureValidToken(ctx context.Context) error {
@@ -147,7 +576,7 @@ func (c *GalaxyClient) ensureValidToken(ctx context.Context) error {
 }
 
 func (c *GalaxyClient) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}) error {
-	return c.doRequestWithRetry(ctx, method, path, body, result, maxRequestRetries)
+	return c.doRequestWithRetry(ctx, method, path, body, result, c.maxRetries)
 }
 
 func sleepCtx(ctx context.Context, d time.Duration) error {
@@ -175,6 +604,11 @@ func apiPath(format string, segments ...string) string {
 	return fmt.Sprintf(format, args...)
 }
 
+// attempt returns the zero-based attempt number of a request with the given retries left.
+func (c *GalaxyClient) attempt(retries int) int {
+	return max(c.maxRetries-retries, 0)
+}
+
 // computeRetryBackoff returns exponential backoff with +/-50% jitter anchored to base, capped at backoffCap.
 func computeRetryBackoff(attempt int, base time.Duration) time.Duration {
 	b := min(base<<attempt, backoffCap)
@@ -182,35 +616,47 @@ func computeRetryBackoff(attempt int, base time.Duration) time.Duration {
 }
 
 func (c *GalaxyClient) doRequestWithRetry(ctx context.Context, method, path string, body interface{}, result interface{}, retries int) error {
+	return c.doRequestWithBudget(ctx, method, path, body, result, &retries)
+}
+
+// doRequestWithBudget sends a request, retrying within *retries and leaving the retries that
+// are still left in it, so that callers repeating a request can share one retry budget.
+func (c *GalaxyClient) doRequestWithBudget(ctx context.Context, method, path string, body interface{}, result interface{}, retries *int) error {
 	if err := c.ensureValidToken(ctx); err != nil {
-		if ctx.Err() != nil || retries <= 0 {
+		if ctx.Err() != nil || *retries <= 0 || errors.Is(err, errAccessTokenExpired) {
 			return err
 		}
-		attempt := maxRequestRetries - retries
+		attempt := c.attempt(*retries)
 		waitTime := computeRetryBackoff(attempt, backoffBase)
 		tflog.Warn(ctx, "Encountered retriable error on token fetch, retrying after backoff", map[string]interface{}{
 			"error":        err.Error(),
 			"wait_time":    waitTime.String(),
-			"retries_left": retries - 1,
+			"retries_left": *retries - 1,
 		})
 		if sleepErr := sleepCtx(ctx, waitTime); sleepErr != nil {
 			return sleepErr
 		}
-		return c.doRequestWithRetry(ctx, method, path, body, result, retries-1)
+		*retries--
+		return c.doRequestWithBudget(ctx, method, path, body, result, retries)
 	}
 
-	if err := sharedLimiter.Wait(ctx); err != nil {
-		return fmt.Errorf("rate limiter wait: %w", err)
-	}
-	if strings.HasPrefix(path, clusterPathPrefix) {
-		if err := sharedClusterLimiter.Wait(ctx); err != nil {
-			return fmt.Errorf("cluster rate limiter wait: %w", err)
+	if !c.unlimited {
+		restoreSharedLimiters(time.Now())
+		if err := waitLimiter(ctx, sharedLimiter); err != nil {
+			return fmt.Errorf("rate limiter wait: %w", err)
+		}
+		if strings.HasPrefix(path, clusterPathPrefix) {
+			if err := waitLimiter(ctx, sharedClusterLimiter); err != nil {
+				return fmt.Errorf("cluster rate limiter wait: %w", err)
+			}
 		}
 	}
 
 	var bodyReader io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
@@ -236,24 +682,29 @@ func (c *GalaxyClient) doRequestWithRetry(ctx context.Context, method, path stri
 	userAgent := fmt.Sprintf("terraform-provider-galaxy/%s", c.ProviderVersion)
 	req.Header.Set("User-Agent", userAgent)
 
+	start := time.Now()
 	resp, err := c.HTTPClient.Do(req)
+	if c.trace {
+		resp = c.traceResponse(ctx, method, path, c.attempt(*retries), jsonBody, resp, err, time.Since(start))
+	}
 	if err != nil {
-		if ctx.Err() != nil || retries <= 0 || method == http.MethodPost {
+		if ctx.Err() != nil || *retries <= 0 || method == http.MethodPost {
 			return fmt.Errorf("request failed: %w", err)
 		}
-		attempt := maxRequestRetries - retries
+		attempt := c.attempt(*retries)
 		waitTime := computeRetryBackoff(attempt, backoffBase)
 		tflog.Warn(ctx, "Encountered retriable error, retrying after backoff", map[string]interface{}{
 			"error":        err.Error(),
 			"wait_time":    waitTime.String(),
-			"retries_left": retries - 1,
+			"retries_left": *retries - 1,
 			"endpoint":     path,
 			"method":       method,
 		})
 		if sleepErr := sleepCtx(ctx, waitTime); sleepErr != nil {
 			return sleepErr
 		}
-		return c.doRequestWithRetry(ctx, method, path, body, result, retries-1)
+		*retries--
+		return c.doRequestWithBudget(ctx, method, path, body, result, retries)
 	}
 	defer func() {
 		if closeErr := resp.Body.Close(); closeErr != nil {
@@ -263,59 +714,68 @@ func (c *GalaxyClient) doRequestWithRetry(ctx context.Context, method, path stri
 			})
 		}
 	}()
+	if !c.unlimited {
+		adaptSharedLimiter(path, resp.Header, time.Now())
+	}
 
 	// Token expiry: clear cache and retry; the refresh is the fix.
-	if (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) && retries > 0 {
+	if (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) && *retries > 0 {
 		c.tokenMu.Lock()
 		c.accessToken = ""
 		c.tokenMu.Unlock()
 
-		if err := sleepCtx(ctx, time.Duration(maxRequestRetries-retries)*time.Second); err != nil {
+		if err := sleepCtx(ctx, time.Duration(c.attempt(*retries))*time.Second); err != nil {
 			return err
 		}
-		return c.doRequestWithRetry(ctx, method, path, body, result, retries-1)
+		*retries--
+		return c.doRequestWithBudget(ctx, method, path, body, result, retries)
 	}
 
 	// 429: retry within budget — context expiry also terminates.
 	if resp.StatusCode == http.StatusTooManyRequests {
-		if retries <= 0 {
+		if *retries <= 0 {
 			return fmt.Errorf("request %s %s rate limited: retry budget exhausted", method, path)
 		}
-		attempt := maxRequestRetries - retries
+		attempt := c.attempt(*retries)
 		base := backoffBase
 		if strings.HasPrefix(path, clusterPathPrefix) {
 			base = clusterBackoffBase
 		}
 		waitTime := computeRetryBackoff(attempt, base)
+		if delay, ok := serverRetryDelay(resp.Header, time.Now()); ok {
+			waitTime = delay
+		}
 		tflog.Warn(ctx, "Encountered retriable error, retrying after backoff", map[string]interface{}{
 			"status_code":  resp.StatusCode,
 			"wait_time":    waitTime.String(),
-			"retries_left": retries - 1,
+			"retries_left": *retries - 1,
 			"endpoint":     path,
 			"method":       method,
 		})
 		if err := sleepCtx(ctx, waitTime); err != nil {
 			return err
 		}
-		return c.doRequestWithRetry(ctx, method, path, body, result, retries-1)
+		*retries--
+		return c.doRequestWithBudget(ctx, method, path, body, result, retries)
 	}
 
 	// 500: only retry on idempotent methods (GET, PUT, PATCH, DELETE) to avoid duplicating non-idempotent operations (POST).
 	// All observed 500s on this API are transient CockroachDB TransactionRetryExhaustedException errors.
-	if retries > 0 && resp.StatusCode == http.StatusInternalServerError && method != http.MethodPost {
-		attempt := maxRequestRetries - retries
+	if *retries > 0 && resp.StatusCode == http.StatusInternalServerError && method != http.MethodPost {
+		attempt := c.attempt(*retries)
 		waitTime := computeRetryBackoff(attempt, backoffBase)
 		tflog.Warn(ctx, "Encountered retriable error, retrying after backoff", map[string]interface{}{
 			"status_code":  resp.StatusCode,
 			"wait_time":    waitTime.String(),
-			"retries_left": retries - 1,
+			"retries_left": *retries - 1,
 			"endpoint":     path,
 			"method":       method,
 		})
 		if err := sleepCtx(ctx, waitTime); err != nil {
 			return err
 		}
-		return c.doRequestWithRetry(ctx, method, path, body, result, retries-1)
+		*retries--
+		return c.doRequestWithBudget(ctx, method, path, body, result, retries)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -328,7 +788,7 @@ func (c *GalaxyClient) doRequestWithRetry(ctx context.Context, method, path stri
 
 	if resp.StatusCode >= 400 {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return newAPIError(resp, method, path, bodyBytes)
 	}
 
 	if result != nil && resp.StatusCode != http.StatusNoContent {
@@ -340,6 +800,101 @@ func (c *GalaxyClient) doRequestWithRetry(ctx context.Context, method, path stri
 	return nil
 }
 
+// sensitiveFields lists fragments of JSON field names whose values are masked in trace logs.
+// Names are compared case-insensitively with underscores removed, so "secretKey" also covers
+// "glue_secret_key" and "token" covers "accessToken".
+var sensitiveFields = []string{"password", "secretkey", "privatekey", "credentialskey", "token", "clientsecret"}
+
+const (
+	redactedValue     = "***"
+	maxTracedBodySize = 16 * 1024
+)
+
+// traceResponse logs a request and the response to it, and returns a response whose body can
+// still be read by the caller.
+func (c *GalaxyClient) traceResponse(ctx context.Context, method, path string, attempt int, requestBody []byte, resp *http.Response, err error, latency time.Duration) *http.Response {
+	var responseBody []byte
+	if resp != nil && resp.Body != nil {
+		var readErr error
+		responseBody, readErr = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+		if readErr != nil && err == nil {
+			err = fmt.Errorf("failed to read response body: %w", readErr)
+		}
+	}
+	c.traceRequest(ctx, method, path, attempt, requestBody, resp, responseBody, err, latency)
+	return resp
+}
+
+// traceRequest logs one HTTP exchange.
+func (c *GalaxyClient) traceRequest(ctx context.Context, method, path string, attempt int, requestBody []byte, resp *http.Response, responseBody []byte, err error, latency time.Duration) {
+	fields := map[string]interface{}{
+		"method":     method,
+		"path":       path,
+		"latency_ms": latency.Milliseconds(),
+		"attempt":    attempt,
+	}
+	if resp != nil {
+		fields["status"] = resp.StatusCode
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	if len(requestBody) > 0 {
+		fields["request_body"] = redactBody(requestBody)
+	}
+	if len(responseBody) > 0 {
+		fields["response_body"] = redactBody(responseBody)
+	}
+	tflog.Info(ctx, "Galaxy API request", fields)
+}
+
+// redactBody returns a JSON body with the values of sensitive fields masked. Bodies that are
+// not JSON are summarized by size, since they cannot be redacted reliably.
+func redactBody(body []byte) string {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return fmt.Sprintf("[%d bytes, not JSON]", len(body))
+	}
+	redacted, err := json.Marshal(redactValue(data))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes]", len(body))
+	}
+	if len(redacted) > maxTracedBodySize {
+		return string(redacted[:maxTracedBodySize]) + "...[truncated]"
+	}
+	return string(redacted)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveField(key) && item != nil {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+func isSensitiveField(name string) bool {
+	normalized := strings.ToLower(strings.ReplaceAll(name, "_", ""))
+	for _, field := range sensitiveFields {
+		if strings.Contains(normalized, field) {
+			return true
+		}
+	}
+	return false
+}
+
 // NotFoundError represents a 404 response
 type NotFoundError struct {
 	Message string
@@ -354,6 +909,112 @@ func IsNotFound(err error) bool {
 	return ok
 }
 
+// APIError represents any other error response from the Galaxy API. Galaxy reports errors as
+// a JSON object with an error code and message; fields that are missing from the response are
+// left empty and Message falls back to the raw body.
+type APIError struct {
+	StatusCode int
+	// Code is the Galaxy error code, e.g. INVALID_ARGUMENT
+	Code    string
+	Message string
+	// Field is the request field a validation error refers to, when Galaxy reports one
+	Field     string
+	RequestID string
+	Method    string
+	Endpoint  string
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "API request failed with status %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&sb, " (%s)", e.Code)
+	}
+	fmt.Fprintf(&sb, " on %s %s", e.Method, e.Endpoint)
+	if e.Message != "" {
+		sb.WriteString(": " + e.Message)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&sb, " [request ID: %s]", e.RequestID)
+	}
+	return sb.String()
+}
+
+// requestIDHeaders lists the response headers that may carry the request ID, in order of preference.
+var requestIDHeaders = []string{"X-Request-Id", "X-Galaxy-Request-Id", "X-Amzn-Trace-Id"}
+
+func newAPIError(resp *http.Response, method, path string, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Endpoint:   path,
+	}
+
+	var payload map[string]interface{}
+	if json.Unmarshal(body, &payload) == nil {
+		apiErr.Code = firstString(payload, "errorCode", "code", "error")
+		apiErr.Message = firstString(payload, "message", "errorMessage", "detail", "error_description")
+		apiErr.Field = firstString(payload, "field", "fieldName", "parameter")
+		apiErr.RequestID = firstString(payload, "requestId")
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+
+	for _, header := range requestIDHeaders {
+		if apiErr.RequestID != "" {
+			break
+		}
+		apiErr.RequestID = resp.Header.Get(header)
+	}
+
+	return apiErr
+}
+
+func firstString(m map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if v, ok := m[key].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// AsAPIError returns the APIError wrapped in err, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	ok := errors.As(err, &apiErr)
+	return apiErr, ok
+}
+
+func hasStatus(err error, statusCodes ...int) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	for _, code := range statusCodes {
+		if apiErr.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsConflict reports whether err is a 409 response, e.g. an object with the same name already exists.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsForbidden reports whether err is a 403 response that persisted after refreshing the token.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsValidation reports whether Galaxy rejected the request body as invalid.
+func IsValidation(err error) bool {
+	return hasStatus(err, http.StatusBadRequest, http.StatusUnprocessableEntity)
+}
+
 // GetAllPaginatedResults fetches all paginated results from an API endpoint.
 // The path may include query parameters (e.g. "/api/v1/cluster?extended=true").
 // This should be used by data sources to automatically handle pagination.
@@ -393,12 +1054,129 @@ func (c *GalaxyClient) GetAllPaginatedResults(ctx context.Context, path string)
 	return allResults, nil
 }
 
+// createLookup finds an object that an earlier, failed POST may have created anyway. It
+// returns a NotFoundError when the object does not exist.
+type createLookup func(ctx context.Context) (map[string]interface{}, error)
+
+// createWithRecovery POSTs body to path. POST is not retried by doRequestWithRetry because
+// the request may have been applied even though the response was lost; here a network error
+// or 5xx response is followed by lookup, and the object is adopted when it exists or the
+// POST is retried when it does not. All attempts share one retry budget of maxRetries.
+func (c *GalaxyClient) createWithRecovery(ctx context.Context, path string, body interface{}, lookup createLookup) (map[string]interface{}, error) {
+	retries := c.maxRetries
+	for {
+		var result map[string]interface{}
+		err := c.doRequestWithBudget(ctx, http.MethodPost, path, body, &result, &retries)
+		if err == nil || retries <= 0 || !isUncertainPostFailure(ctx, err) {
+			return result, err
+		}
+
+		existing, lookupErr := lookup(ctx)
+		if lookupErr == nil {
+			tflog.Info(ctx, "Create request failed but the object exists, adopting it", map[string]interface{}{
+				"error":    err.Error(),
+				"endpoint": path,
+			})
+			return existing, nil
+		}
+		if !IsNotFound(lookupErr) {
+			tflog.Warn(ctx, "Could not check whether a failed create request was applied", map[string]interface{}{
+				"error":    lookupErr.Error(),
+				"endpoint": path,
+			})
+			return nil, fmt.Errorf("%w (could not check whether the object was created anyway: %v)", err, lookupErr)
+		}
+
+		base := backoffBase
+		if c.recoveryBackoff > 0 {
+			base = c.recoveryBackoff
+		}
+		waitTime := computeRetryBackoff(c.attempt(retries), base)
+		tflog.Warn(ctx, "Create request failed and the object does not exist, retrying after backoff", map[string]interface{}{
+			"error":        err.Error(),
+			"wait_time":    waitTime.String(),
+			"retries_left": retries - 1,
+			"endpoint":     path,
+		})
+		if sleepErr := sleepCtx(ctx, waitTime); sleepErr != nil {
+			return nil, sleepErr
+		}
+		retries--
+	}
+}
+
+// isUncertainPostFailure reports whether a POST failed in a way that leaves it unknown
+// whether Galaxy applied it: the connection failed or timed out, or the server errored.
+func isUncertainPostFailure(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode >= http.StatusInternalServerError
+}
+
+// lookupByName returns a createLookup that searches the paginated list at listPath for the
+// item whose listNameKey matches the requestNameKey field of body, and fetches it by its
+// idKey with get.
+func (c *GalaxyClient) lookupByName(body interface{}, requestNameKey, listPath, listNameKey, idKey string, get func(ctx context.Context, id string) (map[string]interface{}, error)) createLookup {
+	list := func(ctx context.Context) ([]map[string]interface{}, error) {
+		items, err := c.GetAllPaginatedResults(ctx, listPath)
+		if err != nil {
+			return nil, err
+		}
+		itemMaps := make([]map[string]interface{}, 0, len(items))
+		for _, item := range items {
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				itemMaps = append(itemMaps, itemMap)
+			}
+		}
+		return itemMaps, nil
+	}
+	return lookupInList(body, requestNameKey, listPath, list, listNameKey, idKey, get)
+}
+
+// lookupInList is lookupByName for lists that list returns in full. A body without a name to
+// search for yields a lookup that fails, so the failed create is reported rather than retried.
+func lookupInList(body interface{}, requestNameKey, listPath string, list func(ctx context.Context) ([]map[string]interface{}, error), listNameKey, idKey string, get func(ctx context.Context, id string) (map[string]interface{}, error)) createLookup {
+	request, ok := body.(map[string]interface{})
+	if !ok {
+		return func(ctx context.Context) (map[string]interface{}, error) {
+			return nil, fmt.Errorf("cannot search %s for a create request body of type %T", listPath, body)
+		}
+	}
+	name, ok := request[requestNameKey].(string)
+	if !ok || name == "" {
+		return func(ctx context.Context) (map[string]interface{}, error) {
+			return nil, fmt.Errorf("cannot search %s: create request has no %s", listPath, requestNameKey)
+		}
+	}
+
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		items, err := list(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if item[listNameKey] != name {
+				continue
+			}
+			if id, ok := item[idKey].(string); ok && id != "" {
+				return get(ctx, id)
+			}
+		}
+		return nil, &NotFoundError{Message: fmt.Sprintf("no object named %s in %s", name, listPath)}
+	}
+}
+
 // Resource-specific methods
 
 func (c *GalaxyClient) CreateCluster(ctx context.Context, cluster interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/cluster?extended=true", cluster, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/cluster?extended=true", cluster,
+		c.lookupByName(cluster, "name", "/public/api/v1/cluster?extended=true", "name", "clusterId", c.GetCluster))
 }
 
 func (c *GalaxyClient) GetCluster(ctx context.Context, clusterID string) (map[string]interface{}, error) {
@@ -418,9 +1196,8 @@ func (c *GalaxyClient) DeleteCluster(ctx context.Context, clusterID string) erro
 }
 
 func (c *GalaxyClient) CreateUser(ctx context.Context, user interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/user", user, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/user", user,
+		c.lookupByName(user, "email", "/public/api/v1/user", "email", "userId", c.GetUser))
 }
 
 func (c *GalaxyClient) GetUser(ctx context.Context, userID string) (map[string]interface{}, error) {
@@ -456,10 +1233,13 @@ func (c *GalaxyClient) UpdateUser(ctx context.Context, userID string, user inter
 	return result, err
 }
 
+func (c *GalaxyClient) DeleteUser(ctx context.Context, userID string) error {
+	return c.doRequest(ctx, "DELETE", apiPath("/public/api/v1/user/%s", userID), nil, nil)
+}
+
 func (c *GalaxyClient) CreateRole(ctx context.Context, role interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/role", role, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/role", role,
+		c.lookupByName(role, "roleName", "/public/api/v1/role", "roleName", "roleId", c.GetRole))
 }
 
 func (c *GalaxyClient) GetRole(ctx context.Context, roleID string) (map[string]interface{}, error) {
@@ -472,10 +1252,11 @@ func (c *GalaxyClient) DeleteRole(ctx context.Context, roleID string) error {
 	return c.doRequest(ctx, "DELETE", "/public/api/v1/role/"+roleID, nil, nil)
 }
 
+// CreateServiceAccount creates a service account. An account adopted after an ambiguous
+// failure is read back with GetServiceAccount, which does not return its initial password.
 func (c *GalaxyClient) CreateServiceAccount(ctx context.Context, account interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/serviceAccount", account, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/serviceAccount", account,
+		lookupInList(account, "username", "/public/api/v1/serviceAccount", c.ListServiceAccounts, "username", "serviceAccountId", c.GetServiceAccount))
 }
 
 func (c *GalaxyClient) GetServiceAccount(ctx context.Context, accountID string) (map[string]interface{}, error) {
@@ -494,6 +1275,10 @@ func (c *GalaxyClient) DeleteServiceAccount(ctx context.Context, accountID strin
 	return c.doRequest(ctx, "DELETE", "/public/api/v1/serviceAccount/"+accountID, nil, nil)
 }
 
+// CreateServiceAccountPassword creates a password. It is not recovered like other creates:
+// the password value is only returned by this request, so an adopted password would be
+// unusable, and passwords have no unique name to find one by. A failed request is reported
+// and a password it may have created can be deleted in Galaxy.
 func (c *GalaxyClient) CreateServiceAccountPassword(ctx context.Context, accountID string, password interface{}) (map[string]interface{}, error) {
 	var result map[string]interface{}
 	err := c.doRequest(ctx, "POST", "/public/api/v1/serviceAccount/"+accountID+"/serviceAccountPassword", password, &result)
@@ -547,10 +1332,12 @@ func (c *GalaxyClient) DeleteServiceAccountPassword(ctx context.Context, account
 
 // Catalog methods - for all catalog types
 func (c *GalaxyClient) CreateCatalog(ctx context.Context, catalogType string, catalog interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
 	path := fmt.Sprintf("/public/api/v1/catalogType/%s/catalog", catalogType)
-	err := c.doRequest(ctx, "POST", path, catalog, &result)
-	return result, err
+	getCatalog := func(ctx context.Context, catalogID string) (map[string]interface{}, error) {
+		return c.GetCatalog(ctx, catalogType, catalogID)
+	}
+	return c.createWithRecovery(ctx, path, catalog,
+		c.lookupByName(catalog, "name", "/public/api/v1/catalog", "catalogName", "catalogId", getCatalog))
 }
 
 func (c *GalaxyClient) GetCatalog(ctx context.Context, catalogType, catalogID string) (map[string]interface{}, error) {
@@ -574,9 +1361,8 @@ func (c *GalaxyClient) DeleteCatalog(ctx context.Context, catalogType, catalogID
 
 // Data Product methods
 func (c *GalaxyClient) CreateDataProduct(ctx context.Context, product interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/dataProduct", product, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/dataProduct", product,
+		c.lookupByName(product, "name", "/public/api/v1/dataProduct", "name", "dataProductId", c.GetDataProduct))
 }
 
 func (c *GalaxyClient) GetDataProduct(ctx context.Context, productID string) (map[string]interface{}, error) {
@@ -677,9 +1463,8 @@ func (c *GalaxyClient) RevokeRolePrivilege(ctx context.Context, roleID string, r
 
 // Column Mask methods
 func (c *GalaxyClient) CreateColumnMask(ctx context.Context, mask interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/columnMask", mask, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/columnMask", mask,
+		c.lookupByName(mask, "name", "/public/api/v1/columnMask", "name", "columnMaskId", c.GetColumnMask))
 }
 
 func (c *GalaxyClient) GetColumnMask(ctx context.Context, maskID string) (map[string]interface{}, error) {
@@ -708,9 +1493,8 @@ func (c *GalaxyClient) ListColumnMasks(ctx context.Context) ([]map[string]interf
 
 // Row Filter methods
 func (c *GalaxyClient) CreateRowFilter(ctx context.Context, filter interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/rowFilter", filter, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/rowFilter", filter,
+		c.lookupByName(filter, "name", "/public/api/v1/rowFilter", "name", "rowFilterId", c.GetRowFilter))
 }
 
 func (c *GalaxyClient) GetRowFilter(ctx context.Context, filterID string) (map[string]interface{}, error) {
@@ -731,9 +1515,8 @@ func (c *GalaxyClient) DeleteRowFilter(ctx context.Context, filterID string) err
 
 // Tag methods
 func (c *GalaxyClient) CreateTag(ctx context.Context, tag interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/tag", tag, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/tag", tag,
+		c.lookupByName(tag, "name", "/public/api/v1/tag", "name", "tagId", c.GetTag))
 }
 
 func (c *GalaxyClient) GetTag(ctx context.Context, tagID string) (map[string]interface{}, error) {
@@ -1025,9 +1808,8 @@ func (c *GalaxyClient) GetPolicy(ctx context.Context, policyID string) (map[stri
 }
 
 func (c *GalaxyClient) CreatePolicy(ctx context.Context, policy interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/policy", policy, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/policy", policy,
+		c.lookupByName(policy, "name", "/public/api/v1/policy", "name", "policyId", c.GetPolicy))
 }
 
 func (c *GalaxyClient) UpdatePolicy(ctx context.Context, policyID string, policy interface{}) (map[string]interface{}, error) {
@@ -1118,6 +1900,72 @@ func (c *GalaxyClient) UnlockRole(roleID string) {
 	}
 }
 
+// LockCluster acquires a per-cluster mutex to serialize read-modify-write operations on its catalogs.
+func (c *GalaxyClient) LockCluster(clusterID string) {
+	mu, _ := c.clusterMu.LoadOrStore(clusterID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+}
+
+// UnlockCluster releases the per-cluster mutex.
+func (c *GalaxyClient) UnlockCluster(clusterID string) {
+	if mu, ok := c.clusterMu.Load(clusterID); ok {
+		mu.(*sync.Mutex).Unlock()
+	}
+}
+
+// EntityTagPath returns the tag collection path of a catalog, schema, table or column.
+// Trailing IDs are left empty to address a higher-level entity, for example only
+// catalogID for a catalog. The path doubles as the key for LockEntityTags.
+func EntityTagPath(catalogID, schemaID, tableID, columnID string) string {
+	switch {
+	case columnID != "":
+		return apiPath("/public/api/v1/catalog/%s/schema/%s/table/%s/column/%s/tag", catalogID, schemaID, tableID, columnID)
+	case tableID != "":
+		return apiPath("/public/api/v1/catalog/%s/schema/%s/table/%s/tag", catalogID, schemaID, tableID)
+	case schemaID != "":
+		return apiPath("/public/api/v1/catalog/%s/schema/%s/tag", catalogID, schemaID)
+	default:
+		return apiPath("/public/api/v1/catalog/%s/tag", catalogID)
+	}
+}
+
+// GetEntityTags returns the tags ({tagId, name}) assigned to the entity at tagPath.
+func (c *GalaxyClient) GetEntityTags(ctx context.Context, tagPath string) ([]map[string]interface{}, error) {
+	results, err := c.GetAllPaginatedResults(ctx, tagPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]map[string]interface{}, 0, len(results))
+	for _, t := range results {
+		if tMap, ok := t.(map[string]interface{}); ok {
+			tags = append(tags, tMap)
+		}
+	}
+	return tags, nil
+}
+
+// UpdateEntityTags replaces the full tag set of the entity at tagPath.
+func (c *GalaxyClient) UpdateEntityTags(ctx context.Context, tagPath string, tagIDs []string) error {
+	body := map[string]interface{}{
+		"tagIds": tagIDs,
+	}
+	return c.doRequest(ctx, "PUT", tagPath, body, nil)
+}
+
+// LockEntityTags acquires a per-entity mutex to serialize read-modify-write operations on its tags.
+func (c *GalaxyClient) LockEntityTags(tagPath string) {
+	mu, _ := c.entityTagMu.LoadOrStore(tagPath, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+}
+
+// UnlockEntityTags releases the per-entity mutex.
+func (c *GalaxyClient) UnlockEntityTags(tagPath string) {
+	if mu, ok := c.entityTagMu.Load(tagPath); ok {
+		mu.(*sync.Mutex).Unlock()
+	}
+}
+
 // UpdateServiceAccountPassword updates a service account password
 func (c *GalaxyClient) UpdateServiceAccountPassword(ctx context.Context, passwordID string, password interface{}) (map[string]interface{}, error) {
 	var result map[string]interface{}
@@ -1127,9 +1975,8 @@ func (c *GalaxyClient) UpdateServiceAccountPassword(ctx context.Context, passwor
 
 // SQL Job methods
 func (c *GalaxyClient) CreateSqlJob(ctx context.Context, sqlJob interface{}) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "POST", "/public/api/v1/sqlJob", sqlJob, &result)
-	return result, err
+	return c.createWithRecovery(ctx, "/public/api/v1/sqlJob", sqlJob,
+		c.lookupByName(sqlJob, "name", "/public/api/v1/sqlJob", "name", "sqlJobId", c.GetSqlJob))
 }
 
 func (c *GalaxyClient) GetSqlJob(ctx context.Context, sqlJobID string) (map[string]interface{}, error) {
@@ -1163,10 +2010,8 @@ func (c *GalaxyClient) GetSqlJobHistory(ctx context.Context, sqlJobID string) (m
 }
 
 // List SQL Jobs data source
-func (c *GalaxyClient) ListSqlJobs(ctx context.Context) (map[string]interface{}, error) {
-	var result map[string]interface{}
-	err := c.doRequest(ctx, "GET", "/public/api/v1/sqlJob", nil, &result)
-	return result, err
+func (c *GalaxyClient) ListSqlJobs(ctx context.Context) ([]interface{}, error) {
+	return c.GetAllPaginatedResults(ctx, "/public/api/v1/sqlJob")
 }
 
 // Privatelink data sources
@@ -1182,6 +2027,23 @@ func (c *GalaxyClient) ListPrivatelinks(ctx context.Context) (map[string]interfa
 	return result, err
 }
 
+// Privatelink resource
+func (c *GalaxyClient) CreatePrivatelink(ctx context.Context, privatelink interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "POST", "/public/api/v1/privatelink", privatelink, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) UpdatePrivatelink(ctx context.Context, privatelinkID string, privatelink interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "PATCH", apiPath("/public/api/v1/privatelink/%s", privatelinkID), privatelink, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) DeletePrivatelink(ctx context.Context, privatelinkID string) error {
+	return c.doRequest(ctx, "DELETE", apiPath("/public/api/v1/privatelink/%s", privatelinkID), nil, nil)
+}
+
 // Data Quality data sources
 func (c *GalaxyClient) GetDataQualitySummary(ctx context.Context, catalogID string) (map[string]interface{}, error) {
 	var result map[string]interface{}
@@ -1273,6 +2135,28 @@ func (c *GalaxyClient) ListUsageExamples(ctx context.Context, dataProductID stri
 	return result, err
 }
 
+func (c *GalaxyClient) CreateUsageExample(ctx context.Context, dataProductID string, example interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "POST", apiPath("/public/api/v1/dataProduct/%s/usageExample", dataProductID), example, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) GetUsageExample(ctx context.Context, dataProductID, usageExampleID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "GET", apiPath("/public/api/v1/dataProduct/%s/usageExample/%s", dataProductID, usageExampleID), nil, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) UpdateUsageExample(ctx context.Context, dataProductID, usageExampleID string, example interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "PATCH", apiPath("/public/api/v1/dataProduct/%s/usageExample/%s", dataProductID, usageExampleID), example, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) DeleteUsageExample(ctx context.Context, dataProductID, usageExampleID string) error {
+	return c.doRequest(ctx, "DELETE", apiPath("/public/api/v1/dataProduct/%s/usageExample/%s", dataProductID, usageExampleID), nil, nil)
+}
+
 // Evaluation data source
 func (c *GalaxyClient) GetEvaluation(ctx context.Context, checkID string) (map[string]interface{}, error) {
 	var result map[string]interface{}
@@ -1286,3 +2170,125 @@ func (c *GalaxyClient) GetDataQualitySchedule(ctx context.Context, catalogID, sc
 	err := c.doRequest(ctx, "GET", apiPath("/public/api/v1/catalog/%s/schema/%s/table/%s/dataQualitySchedule", catalogID, schemaID, tableID), nil, &result)
 	return result, err
 }
+
+// Data Quality Schedule resource
+func (c *GalaxyClient) CreateDataQualitySchedule(ctx context.Context, catalogID, schemaID, tableID string, schedule interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "POST", apiPath("/public/api/v1/catalog/%s/schema/%s/table/%s/dataQualitySchedule", catalogID, schemaID, tableID), schedule, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) UpdateDataQualitySchedule(ctx context.Context, catalogID, schemaID, tableID string, schedule interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "PATCH", apiPath("/public/api/v1/catalog/%s/schema/%s/table/%s/dataQualitySchedule", catalogID, schemaID, tableID), schedule, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) DeleteDataQualitySchedule(ctx context.Context, catalogID, schemaID, tableID string) error {
+	return c.doRequest(ctx, "DELETE", apiPath("/public/api/v1/catalog/%s/schema/%s/table/%s/dataQualitySchedule", catalogID, schemaID, tableID), nil, nil)
+}
+
+// SSH Tunnel methods
+func (c *GalaxyClient) CreateSshTunnel(ctx context.Context, tunnel interface{}) (map[string]interface{}, error) {
+	return c.createWithRecovery(ctx, "/public/api/v1/sshTunnel", tunnel,
+		c.lookupByName(tunnel, "name", "/public/api/v1/sshTunnel", "name", "sshTunnelId", c.GetSshTunnel))
+}
+
+func (c *GalaxyClient) GetSshTunnel(ctx context.Context, sshTunnelID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "GET", apiPath("/public/api/v1/sshTunnel/%s", sshTunnelID), nil, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) UpdateSshTunnel(ctx context.Context, sshTunnelID string, tunnel interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "PATCH", apiPath("/public/api/v1/sshTunnel/%s", sshTunnelID), tunnel, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) DeleteSshTunnel(ctx context.Context, sshTunnelID string) error {
+	return c.doRequest(ctx, "DELETE", apiPath("/public/api/v1/sshTunnel/%s", sshTunnelID), nil, nil)
+}
+
+func (c *GalaxyClient) ListSshTunnels(ctx context.Context) ([]interface{}, error) {
+	return c.GetAllPaginatedResults(ctx, "/public/api/v1/sshTunnel")
+}
+
+// File Ingest Source methods
+func (c *GalaxyClient) CreateFileIngestSource(ctx context.Context, source interface{}) (map[string]interface{}, error) {
+	return c.createWithRecovery(ctx, "/public/api/v1/fileIngestSource", source,
+		c.lookupByName(source, "name", "/public/api/v1/fileIngestSource", "name", "fileIngestSourceId", c.GetFileIngestSource))
+}
+
+func (c *GalaxyClient) GetFileIngestSource(ctx context.Context, sourceID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "GET", apiPath("/public/api/v1/fileIngestSource/%s", sourceID), nil, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) UpdateFileIngestSource(ctx context.Context, sourceID string, source interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "PATCH", apiPath("/public/api/v1/fileIngestSource/%s", sourceID), source, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) DeleteFileIngestSource(ctx context.Context, sourceID string) error {
+	return c.doRequest(ctx, "DELETE", apiPath("/public/api/v1/fileIngestSource/%s", sourceID), nil, nil)
+}
+
+// Kafka Ingest Source methods
+func (c *GalaxyClient) CreateKafkaIngestSource(ctx context.Context, source interface{}) (map[string]interface{}, error) {
+	return c.createWithRecovery(ctx, "/public/api/v1/kafkaIngestSource", source,
+		c.lookupByName(source, "name", "/public/api/v1/kafkaIngestSource", "name", "kafkaIngestSourceId", c.GetKafkaIngestSource))
+}
+
+func (c *GalaxyClient) GetKafkaIngestSource(ctx context.Context, sourceID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "GET", apiPath("/public/api/v1/kafkaIngestSource/%s", sourceID), nil, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) UpdateKafkaIngestSource(ctx context.Context, sourceID string, source interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "PATCH", apiPath("/public/api/v1/kafkaIngestSource/%s", sourceID), source, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) DeleteKafkaIngestSource(ctx context.Context, sourceID string) error {
+	return c.doRequest(ctx, "DELETE", apiPath("/public/api/v1/kafkaIngestSource/%s", sourceID), nil, nil)
+}
+
+// Ingest Stream methods
+func (c *GalaxyClient) CreateIngestStream(ctx context.Context, stream interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "POST", "/public/api/v1/ingestStream", stream, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) GetIngestStream(ctx context.Context, streamID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "GET", apiPath("/public/api/v1/ingestStream/%s", streamID), nil, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) UpdateIngestStream(ctx context.Context, streamID string, stream interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "PATCH", apiPath("/public/api/v1/ingestStream/%s", streamID), stream, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) DeleteIngestStream(ctx context.Context, streamID string) error {
+	return c.doRequest(ctx, "DELETE", apiPath("/public/api/v1/ingestStream/%s", streamID), nil, nil)
+}
+
+func (c *GalaxyClient) StartIngestStream(ctx context.Context, streamID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "POST", apiPath("/public/api/v1/ingestStream/%s/start", streamID), nil, &result)
+	return result, err
+}
+
+func (c *GalaxyClient) StopIngestStream(ctx context.Context, streamID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.doRequest(ctx, "POST", apiPath("/public/api/v1/ingestStream/%s/stop", streamID), nil, &result)
+	return result, err
+}
@@ -10,12 +10,31 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"io"
+	"math/big"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"golang.org/x/time/rate"
 )
 
 func TestComputeRetryBackoff_ExponentialWithJitterByAttempt(t *testing.T) {
@@ -134,3 +153,674 @@ func TestPost500NotRetried(t *testing.T) {
 		t.Fatalf("POST 500 should not be retried; expected 1 request, got %d", mock.requestCount)
 	}
 }
+
+type bodyRoundTripper struct {
+	statusCode int
+	header     http.Header
+	body       string
+}
+
+func (b *bodyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: b.statusCode,
+		Header:     b.header,
+		Body:       io.NopCloser(strings.NewReader(b.body)),
+		Request:    req,
+	}, nil
+}
+
+func newTestClient(transport http.RoundTripper) *GalaxyClient {
+	return &GalaxyClient{
+		BaseURL:         "http://localhost",
+		ClientID:        "test",
+		ClientSecret:    "test",
+		ProviderVersion: "1.0.0",
+		HTTPClient: &http.Client{
+			Transport: transport,
+		},
+		accessToken: "test-token",
+		tokenExpiry: time.Now().Add(1 * time.Hour),
+	}
+}
+
+func TestAPIError_ParsesGalaxyErrorBody(t *testing.T) {
+	transport := &bodyRoundTripper{
+		statusCode: http.StatusBadRequest,
+		header:     http.Header{"X-Request-Id": []string{"req-123"}},
+		body:       `{"errorCode":"INVALID_ARGUMENT","message":"minWorkers must be at least 1","field":"minWorkers"}`,
+	}
+	client := newTestClient(transport)
+
+	err := client.doRequestWithRetry(context.Background(), http.MethodPost, "/public/api/v1/cluster", nil, nil, 0)
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "INVALID_ARGUMENT" || apiErr.Field != "minWorkers" || apiErr.RequestID != "req-123" {
+		t.Fatalf("unexpected APIError fields: %+v", apiErr)
+	}
+	if apiErr.Method != http.MethodPost || apiErr.Endpoint != "/public/api/v1/cluster" {
+		t.Fatalf("unexpected endpoint: %s %s", apiErr.Method, apiErr.Endpoint)
+	}
+	want := "API request failed with status 400 (INVALID_ARGUMENT) on POST /public/api/v1/cluster: minWorkers must be at least 1 [request ID: req-123]"
+	if err.Error() != want {
+		t.Fatalf("unexpected message:\n got: %s\nwant: %s", err.Error(), want)
+	}
+	if !IsValidation(err) || IsConflict(err) || IsForbidden(err) || IsNotFound(err) {
+		t.Fatalf("unexpected classification for %v", err)
+	}
+}
+
+func TestAPIError_NonJSONBody(t *testing.T) {
+	client := newTestClient(&bodyRoundTripper{statusCode: http.StatusConflict, header: http.Header{}, body: "already exists\n"})
+
+	err := client.doRequestWithRetry(context.Background(), http.MethodPost, "/public/api/v1/role", nil, nil, 0)
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Message != "already exists" || apiErr.Code != "" {
+		t.Fatalf("unexpected APIError fields: %+v", apiErr)
+	}
+	if !IsConflict(err) || IsValidation(err) {
+		t.Fatalf("unexpected classification for %v", err)
+	}
+}
+
+func TestAPIError_ForbiddenAfterTokenRefresh(t *testing.T) {
+	client := newTestClient(&bodyRoundTripper{statusCode: http.StatusForbidden, header: http.Header{}, body: `{"message":"denied"}`})
+
+	err := client.doRequestWithRetry(context.Background(), http.MethodGet, "/public/api/v1/role", nil, nil, 0)
+	if !IsForbidden(err) {
+		t.Fatalf("expected forbidden error, got %v", err)
+	}
+	if IsForbidden(nil) || IsForbidden(&NotFoundError{Message: "x"}) {
+		t.Fatal("IsForbidden should only match APIError")
+	}
+}
+
+func TestNewGalaxyClient_Options(t *testing.T) {
+	c := NewGalaxyClient("http://localhost/", "id", "secret", "1.0.0", Options{})
+	if c.maxRetries != maxRequestRetries || c.HTTPClient.Timeout != defaultRequestTimeout {
+		t.Fatalf("expected defaults, got maxRetries=%d timeout=%v", c.maxRetries, c.HTTPClient.Timeout)
+	}
+
+	c = NewGalaxyClient("http://localhost", "id", "secret", "1.0.0", Options{MaxRetries: 8, RequestTimeout: 2 * time.Minute})
+	if c.maxRetries != 8 || c.HTTPClient.Timeout != 2*time.Minute {
+		t.Fatalf("expected configured values, got maxRetries=%d timeout=%v", c.maxRetries, c.HTTPClient.Timeout)
+	}
+
+	c = NewGalaxyClient("http://localhost", "id", "secret", "1.0.0", Options{MaxRetries: -1})
+	if c.maxRetries != 0 {
+		t.Fatalf("expected retries disabled, got %d", c.maxRetries)
+	}
+}
+
+func TestConfigureSharedLimiter_LowestRateWins(t *testing.T) {
+	limiter := rate.NewLimiter(rate.Limit(requestRateLimitPerSec), requestBurstLimit)
+	var configured float64
+
+	configureSharedLimiter(limiter, &configured, 0)
+	if limiter.Limit() != rate.Limit(requestRateLimitPerSec) {
+		t.Fatalf("unset rate should keep the default, got %v", limiter.Limit())
+	}
+
+	configureSharedLimiter(limiter, &configured, 5)
+	if limiter.Limit() != 5 {
+		t.Fatalf("first configured rate should apply, got %v", limiter.Limit())
+	}
+
+	configureSharedLimiter(limiter, &configured, 8)
+	if limiter.Limit() != 5 {
+		t.Fatalf("higher rate should not replace a lower one, got %v", limiter.Limit())
+	}
+
+	configureSharedLimiter(limiter, &configured, 2)
+	if limiter.Limit() != 2 {
+		t.Fatalf("lower rate should apply, got %v", limiter.Limit())
+	}
+}
+
+// routeRoundTripper answers each "METHOD path" with a canned response and records the
+// requests it served.
+type routeRoundTripper struct {
+	routes   map[string]*bodyRoundTripper
+	requests []string
+}
+
+func (r *routeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.RequestURI()
+	r.requests = append(r.requests, key)
+	route, ok := r.routes[key]
+	if !ok {
+		route = &bodyRoundTripper{statusCode: http.StatusNotFound, header: http.Header{}}
+	}
+	return route.RoundTrip(req)
+}
+
+func TestCreateWithRecovery_AdoptsObjectCreatedByFailedPost(t *testing.T) {
+	transport := &routeRoundTripper{routes: map[string]*bodyRoundTripper{
+		"POST /public/api/v1/role":    {statusCode: http.StatusInternalServerError, header: http.Header{}, body: `{"message":"boom"}`},
+		"GET /public/api/v1/role":     {statusCode: http.StatusOK, header: http.Header{}, body: `{"result":[{"roleId":"r-1","roleName":"other"},{"roleId":"r-2","roleName":"analyst"}]}`},
+		"GET /public/api/v1/role/r-2": {statusCode: http.StatusOK, header: http.Header{}, body: `{"roleId":"r-2","roleName":"analyst"}`},
+	}}
+	client := newTestClient(transport)
+	client.maxRetries = 2
+
+	result, err := client.CreateRole(context.Background(), map[string]interface{}{"roleName": "analyst"})
+	if err != nil {
+		t.Fatalf("expected the existing role to be adopted, got %v", err)
+	}
+	if result["roleId"] != "r-2" {
+		t.Fatalf("expected role r-2, got %v", result)
+	}
+
+	want := []string{"POST /public/api/v1/role", "GET /public/api/v1/role", "GET /public/api/v1/role/r-2"}
+	if strings.Join(transport.requests, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected requests %v, got %v", want, transport.requests)
+	}
+}
+
+func TestCreateWithRecovery_ClientErrorsAreNotRecovered(t *testing.T) {
+	transport := &routeRoundTripper{routes: map[string]*bodyRoundTripper{
+		"POST /public/api/v1/tag": {statusCode: http.StatusConflict, header: http.Header{}, body: `{"message":"exists"}`},
+	}}
+	client := newTestClient(transport)
+	client.maxRetries = 2
+
+	_, err := client.CreateTag(context.Background(), map[string]interface{}{"name": "pii"})
+	if !IsConflict(err) {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+	if len(transport.requests) != 1 {
+		t.Fatalf("expected a single POST, got %v", transport.requests)
+	}
+}
+
+func TestCreateWithRecovery_LookupFailureReturnsOriginalError(t *testing.T) {
+	transport := &routeRoundTripper{routes: map[string]*bodyRoundTripper{
+		"POST /public/api/v1/tag": {statusCode: http.StatusBadGateway, header: http.Header{}, body: `{"message":"bad gateway"}`},
+		"GET /public/api/v1/tag":  {statusCode: http.StatusBadRequest, header: http.Header{}, body: `{"message":"bad"}`},
+	}}
+	client := newTestClient(transport)
+	client.maxRetries = 2
+
+	_, err := client.CreateTag(context.Background(), map[string]interface{}{"name": "pii"})
+	apiErr, ok := AsAPIError(err)
+	if !ok || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected the original 502 error, got %v", err)
+	}
+	if len(transport.requests) != 2 {
+		t.Fatalf("expected POST and list requests, got %v", transport.requests)
+	}
+}
+
+// routeSequenceRoundTripper answers each "METHOD path" with the next of its canned
+// responses, repeating the last one, and records the requests it served.
+type routeSequenceRoundTripper struct {
+	routes   map[string][]*bodyRoundTripper
+	requests []string
+}
+
+func (s *routeSequenceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.RequestURI()
+	served := 0
+	for _, request := range s.requests {
+		if request == key {
+			served++
+		}
+	}
+	s.requests = append(s.requests, key)
+	responses, ok := s.routes[key]
+	if !ok {
+		return (&bodyRoundTripper{statusCode: http.StatusNotFound, header: http.Header{}}).RoundTrip(req)
+	}
+	return responses[min(served, len(responses)-1)].RoundTrip(req)
+}
+
+func TestCreateWithRecovery_RetriesPostWhenObjectIsMissing(t *testing.T) {
+	transport := &routeSequenceRoundTripper{routes: map[string][]*bodyRoundTripper{
+		"POST /public/api/v1/tag": {
+			{statusCode: http.StatusBadGateway, header: http.Header{}, body: `{"message":"bad gateway"}`},
+			{statusCode: http.StatusOK, header: http.Header{}, body: `{"tagId":"t-1","name":"pii"}`},
+		},
+		"GET /public/api/v1/tag": {{statusCode: http.StatusOK, header: http.Header{}, body: `{"result":[{"tagId":"t-0","name":"other"}]}`}},
+	}}
+	client := newTestClient(transport)
+	client.maxRetries = 2
+	client.recoveryBackoff = time.Millisecond
+
+	result, err := client.CreateTag(context.Background(), map[string]interface{}{"name": "pii"})
+	if err != nil {
+		t.Fatalf("expected the retried create to succeed, got %v", err)
+	}
+	if result["tagId"] != "t-1" {
+		t.Fatalf("expected tag t-1, got %v", result)
+	}
+
+	want := []string{"POST /public/api/v1/tag", "GET /public/api/v1/tag", "POST /public/api/v1/tag"}
+	if strings.Join(transport.requests, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected requests %v, got %v", want, transport.requests)
+	}
+}
+
+func TestCreateWithRecovery_SharesRetryBudget(t *testing.T) {
+	// Every POST is first rate limited, then fails with a server error. The rate-limit retries
+	// and the recovery retries draw on the same budget.
+	rateLimited := &bodyRoundTripper{statusCode: http.StatusTooManyRequests, header: http.Header{"Retry-After": []string{"0"}}}
+	serverError := &bodyRoundTripper{statusCode: http.StatusInternalServerError, header: http.Header{}, body: `{"message":"boom"}`}
+	transport := &routeSequenceRoundTripper{routes: map[string][]*bodyRoundTripper{
+		"POST /public/api/v1/tag": {rateLimited, serverError, rateLimited, serverError, rateLimited, serverError},
+		"GET /public/api/v1/tag":  {{statusCode: http.StatusOK, header: http.Header{}, body: `{"result":[]}`}},
+	}}
+	client := newTestClient(transport)
+	client.maxRetries = 2
+	client.recoveryBackoff = time.Millisecond
+
+	if _, err := client.CreateTag(context.Background(), map[string]interface{}{"name": "pii"}); err == nil {
+		t.Fatal("expected the create to fail")
+	}
+
+	posts := 0
+	for _, request := range transport.requests {
+		if request == "POST /public/api/v1/tag" {
+			posts++
+		}
+	}
+	if posts != client.maxRetries+1 {
+		t.Fatalf("expected %d POSTs within the retry budget, got %v", client.maxRetries+1, transport.requests)
+	}
+}
+
+func TestCreateWithRecovery_LookupWithoutNameFails(t *testing.T) {
+	transport := &routeRoundTripper{routes: map[string]*bodyRoundTripper{
+		"POST /public/api/v1/tag": {statusCode: http.StatusBadGateway, header: http.Header{}, body: `{"message":"bad gateway"}`},
+	}}
+	client := newTestClient(transport)
+	client.maxRetries = 2
+
+	for _, body := range []interface{}{map[string]interface{}{}, []string{"pii"}} {
+		transport.requests = nil
+		_, err := client.CreateTag(context.Background(), body)
+		apiErr, ok := AsAPIError(err)
+		if !ok || apiErr.StatusCode != http.StatusBadGateway || !strings.Contains(err.Error(), "could not check whether the object was created") {
+			t.Fatalf("expected the 502 error with the failed lookup, got %v", err)
+		}
+		if len(transport.requests) != 1 {
+			t.Fatalf("expected a single POST, got %v", transport.requests)
+		}
+	}
+}
+
+func TestServerRetryDelay(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+		ok     bool
+	}{
+		{"none", http.Header{}, 0, false},
+		{"retry-after seconds", http.Header{"Retry-After": {"7"}}, 7 * time.Second, true},
+		{"retry-after date", http.Header{"Retry-After": {now.Add(90 * time.Second).Format(http.TimeFormat)}}, 90 * time.Second, true},
+		{"retry-after capped", http.Header{"Retry-After": {"3600"}}, maxServerRetryDelay, true},
+		{"budget exhausted", http.Header{"Ratelimit-Remaining": {"0"}, "Ratelimit-Reset": {"12"}}, 12 * time.Second, true},
+		{"budget left", http.Header{"Ratelimit-Remaining": {"3"}, "Ratelimit-Reset": {"12"}}, 0, false},
+		{"unix reset", http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {strconv.FormatInt(now.Add(20*time.Second).Unix(), 10)}}, 20 * time.Second, true},
+		{"malformed", http.Header{"Retry-After": {"soon"}}, 0, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := serverRetryDelay(tc.header, now)
+			if got != tc.want || ok != tc.ok {
+				t.Fatalf("expected (%v, %v), got (%v, %v)", tc.want, tc.ok, got, ok)
+			}
+		})
+	}
+}
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name      string
+		header    http.Header
+		remaining int
+		reset     time.Duration
+		ok        bool
+	}{
+		{"delta seconds", http.Header{"Ratelimit-Remaining": {"4"}, "Ratelimit-Reset": {"30"}}, 4, 30 * time.Second, true},
+		{"largest delta", http.Header{"Ratelimit-Remaining": {"4"}, "Ratelimit-Reset": {strconv.Itoa(rateLimitResetEpochThreshold - 1)}}, 4, maxServerRetryDelay, true},
+		{"unix timestamp", http.Header{"X-Ratelimit-Remaining": {"2"}, "X-Ratelimit-Reset": {strconv.FormatInt(now.Add(45*time.Second).Unix(), 10)}}, 2, 45 * time.Second, true},
+		{"past timestamp", http.Header{"X-Ratelimit-Remaining": {"2"}, "X-Ratelimit-Reset": {strconv.Itoa(rateLimitResetEpochThreshold)}}, 0, 0, false},
+		{"missing reset", http.Header{"Ratelimit-Remaining": {"2"}}, 0, 0, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			remaining, reset, ok := parseRateLimitHeaders(tc.header, now)
+			if remaining != tc.remaining || reset != tc.reset || ok != tc.ok {
+				t.Fatalf("expected (%v, %v, %v), got (%v, %v, %v)", tc.remaining, tc.reset, tc.ok, remaining, reset, ok)
+			}
+		})
+	}
+}
+
+func TestAdaptSharedLimiter(t *testing.T) {
+	sharedLimitMu.Lock()
+	previousLimit := sharedLimiter.Limit()
+	sharedLimitMu.Unlock()
+	t.Cleanup(func() {
+		sharedLimitMu.Lock()
+		sharedLimiter.SetLimit(previousLimit)
+		adaptedUntil = time.Time{}
+		sharedLimitMu.Unlock()
+	})
+
+	now := time.Now()
+	adaptSharedLimiter("/public/api/v1/role", http.Header{"Ratelimit-Remaining": {"5"}, "Ratelimit-Reset": {"10"}}, now)
+	if sharedLimiter.Limit() != 0.5 {
+		t.Fatalf("expected the remaining budget spread over the reset window, got %v", sharedLimiter.Limit())
+	}
+
+	adaptSharedLimiter("/public/api/v1/role", http.Header{"Ratelimit-Remaining": {"500"}, "Ratelimit-Reset": {"10"}}, now)
+	if sharedLimiter.Limit() != baseRateLimit(false) {
+		t.Fatalf("expected the limiter not to exceed its base rate, got %v", sharedLimiter.Limit())
+	}
+
+	adaptSharedLimiter("/public/api/v1/role", http.Header{"Ratelimit-Remaining": {"1"}, "Ratelimit-Reset": {"10"}}, now)
+	restoreSharedLimiters(now.Add(5 * time.Second))
+	if sharedLimiter.Limit() != 0.1 {
+		t.Fatalf("expected the adapted rate to hold until the reset, got %v", sharedLimiter.Limit())
+	}
+	restoreSharedLimiters(now.Add(11 * time.Second))
+	if sharedLimiter.Limit() != baseRateLimit(false) {
+		t.Fatalf("expected the base rate after the reset, got %v", sharedLimiter.Limit())
+	}
+}
+
+func TestDisableRateLimitsLeavesSharedLimitersAlone(t *testing.T) {
+	sharedLimitMu.Lock()
+	previousLimit := sharedLimiter.Limit()
+	previousConfigured := configuredRateLimit
+	sharedLimitMu.Unlock()
+	t.Cleanup(func() {
+		sharedLimitMu.Lock()
+		sharedLimiter.SetLimit(previousLimit)
+		configuredRateLimit = previousConfigured
+		adaptedUntil = time.Time{}
+		sharedLimitMu.Unlock()
+	})
+
+	c := NewGalaxyClient("http://localhost", "", "", "test", Options{
+		AccessToken:        "test-token",
+		MaxRetries:         -1,
+		RateLimitPerSecond: 0.01,
+		DisableRateLimits:  true,
+		Transport: &bodyRoundTripper{
+			statusCode: http.StatusOK,
+			header:     http.Header{"Ratelimit-Remaining": {"1"}, "Ratelimit-Reset": {"10"}},
+			body:       `{}`,
+		},
+	})
+	for i := 0; i < 3; i++ {
+		if err := c.doRequest(context.Background(), http.MethodGet, "/public/api/v1/role", nil, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	sharedLimitMu.Lock()
+	defer sharedLimitMu.Unlock()
+	if sharedLimiter.Limit() != previousLimit || configuredRateLimit != previousConfigured {
+		t.Fatalf("expected the shared limiter to keep rate %v, got %v", previousLimit, sharedLimiter.Limit())
+	}
+}
+
+// sequenceRoundTripper answers requests with its responses in order.
+type sequenceRoundTripper struct {
+	responses []*bodyRoundTripper
+	requests  int
+}
+
+func (s *sequenceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	response := s.responses[min(s.requests, len(s.responses)-1)]
+	s.requests++
+	return response.RoundTrip(req)
+}
+
+func TestRateLimitedRequestHonorsRetryAfter(t *testing.T) {
+	transport := &sequenceRoundTripper{responses: []*bodyRoundTripper{
+		{statusCode: http.StatusTooManyRequests, header: http.Header{"Retry-After": {"0"}}},
+		{statusCode: http.StatusOK, header: http.Header{}, body: `{"ok":true}`},
+	}}
+	client := newTestClient(transport)
+
+	start := time.Now()
+	var result map[string]interface{}
+	if err := client.doRequestWithRetry(context.Background(), http.MethodGet, "/public/api/v1/tag", nil, &result, 2); err != nil {
+		t.Fatalf("expected the retry to succeed, got %v", err)
+	}
+	if transport.requests != 2 || result["ok"] != true {
+		t.Fatalf("expected one retry, got %d requests and %v", transport.requests, result)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected Retry-After to replace the fixed backoff, waited %v", elapsed)
+	}
+}
+
+// testCertificatePEM returns a self-signed certificate and its private key.
+func testCertificatePEM(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "galaxy-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestNewTransport(t *testing.T) {
+	certPEM, keyPEM := testCertificatePEM(t)
+
+	transport, err := NewTransport(TransportOptions{
+		ProxyURL:      "http://proxy.example.com:3128",
+		CACertPEM:     certPEM,
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://example.galaxy.starburst.io", nil)
+	proxyURL, err := transport.Proxy(req)
+	if err != nil || proxyURL.String() != "http://proxy.example.com:3128" {
+		t.Errorf("expected the configured proxy, got %v, %v", proxyURL, err)
+	}
+	if transport.TLSClientConfig.RootCAs == nil || len(transport.TLSClientConfig.Certificates) != 1 {
+		t.Errorf("expected custom roots and a client certificate")
+	}
+	if transport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("expected certificate verification to stay enabled")
+	}
+
+	invalid := []TransportOptions{
+		{ProxyURL: "ftp://proxy.example.com"},
+		{ProxyURL: "http://"},
+		{CACertPEM: []byte("not a certificate")},
+		{ClientCertPEM: certPEM},
+	}
+	for _, opts := range invalid {
+		if _, err := NewTransport(opts); err == nil {
+			t.Errorf("expected an error for %+v", opts)
+		}
+	}
+}
+
+func testJWT(t *testing.T, exp time.Time) string {
+	t.Helper()
+	payload, err := json.Marshal(map[string]interface{}{"sub": "ci", "exp": exp.Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+func TestStaticToken(t *testing.T) {
+	ctx := context.Background()
+
+	token, expiry, err := staticToken("opaque-token")(ctx)
+	if err != nil || token != "opaque-token" || !expiry.After(time.Now()) {
+		t.Fatalf("expected an opaque token to be used as is, got %q, %v, %v", token, expiry, err)
+	}
+
+	exp := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	jwt := testJWT(t, exp)
+	token, expiry, err = staticToken(jwt)(ctx)
+	if err != nil || token != jwt || !expiry.Equal(exp) {
+		t.Fatalf("expected the JWT to expire at its exp claim, got %v, %v", expiry, err)
+	}
+
+	_, _, err = staticToken(testJWT(t, time.Now().Add(-time.Minute)))(ctx)
+	if !errors.Is(err, errAccessTokenExpired) {
+		t.Fatalf("expected an expired token error, got %v", err)
+	}
+}
+
+func TestExpiredAccessTokenIsNotRetried(t *testing.T) {
+	transport := &routeRoundTripper{}
+	client := newTestClient(transport)
+	client.accessToken = ""
+	client.maxRetries = 3
+	client.refreshToken = staticToken(testJWT(t, time.Now().Add(-time.Minute)))
+
+	err := client.doRequest(context.Background(), http.MethodGet, "/public/api/v1/tag", nil, nil)
+	if !errors.Is(err, errAccessTokenExpired) || len(transport.requests) != 0 {
+		t.Fatalf("expected the request to fail without retries, got %v after %v", err, transport.requests)
+	}
+}
+
+// formRoundTripper answers token requests and records the submitted form.
+type formRoundTripper struct {
+	form   url.Values
+	header http.Header
+}
+
+func (f *formRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.ParseForm(); err != nil {
+		return nil, err
+	}
+	f.form = req.PostForm
+	f.header = req.Header
+	return (&bodyRoundTripper{
+		statusCode: http.StatusOK,
+		header:     http.Header{},
+		body:       `{"access_token":"galaxy-token","token_type":"Bearer","expires_in":3600}`,
+	}).RoundTrip(req)
+}
+
+func TestOIDCTokenExchange(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "id-token")
+	if err := os.WriteFile(tokenFile, []byte("ci-id-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	transport := &formRoundTripper{}
+	client := NewGalaxyClient("http://localhost", "federated-client", "", "1.0.0", Options{OIDCTokenFile: tokenFile, Transport: transport})
+	if err := client.getAccessToken(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if client.accessToken != "galaxy-token" {
+		t.Errorf("expected the exchanged token, got %q", client.accessToken)
+	}
+	if transport.form.Get("grant_type") != tokenExchangeGrantType ||
+		transport.form.Get("subject_token") != "ci-id-token" ||
+		transport.form.Get("subject_token_type") != idTokenType ||
+		transport.form.Get("client_id") != "federated-client" {
+		t.Errorf("unexpected token exchange request: %v", transport.form)
+	}
+	if transport.header.Get("Authorization") != "" {
+		t.Errorf("expected no client secret to be sent, got %q", transport.header.Get("Authorization"))
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	body := []byte(`{"name":"lake","secretKey":"s3cr3t","glue_secret_key":"g1ue","credentials":{"password":"pw","user":"admin"},` +
+		`"keys":[{"privateKey":"pk"}],"credentialsKey":"ck","accessToken":"at","passphrase":null}`)
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(redactBody(body)), &got); err != nil {
+		t.Fatalf("expected JSON output: %v", err)
+	}
+	want := map[string]interface{}{
+		"name":            "lake",
+		"secretKey":       redactedValue,
+		"glue_secret_key": redactedValue,
+		"credentials":     map[string]interface{}{"password": redactedValue, "user": "admin"},
+		"keys":            []interface{}{map[string]interface{}{"privateKey": redactedValue}},
+		"credentialsKey":  redactedValue,
+		"accessToken":     redactedValue,
+		"passphrase":      nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if got := redactBody([]byte("password=hunter2")); strings.Contains(got, "hunter2") {
+		t.Errorf("expected non-JSON bodies to be summarized, got %q", got)
+	}
+}
+
+func TestHTTPTrace(t *testing.T) {
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	client := newTestClient(&bodyRoundTripper{statusCode: http.StatusOK, header: http.Header{}, body: `{"catalogId":"c-1","password":"pw"}`})
+	client.trace = true
+
+	var result map[string]interface{}
+	err := client.doRequest(ctx, http.MethodPost, "/public/api/v1/catalogType/mysql/catalog", map[string]interface{}{"name": "db", "password": "hunter2"}, &result)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result["catalogId"] != "c-1" {
+		t.Errorf("expected the traced response body to still be decoded, got %v", result)
+	}
+
+	if strings.Contains(logs.String(), "hunter2") {
+		t.Errorf("expected the request password to be redacted: %s", logs.String())
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var trace map[string]interface{}
+	for _, entry := range entries {
+		if entry["@message"] == "Galaxy API request" {
+			trace = entry
+		}
+	}
+	if trace == nil {
+		t.Fatalf("expected a trace entry, got %v", entries)
+	}
+	if trace["method"] != "POST" || trace["status"] != float64(http.StatusOK) || trace["attempt"] != float64(0) {
+		t.Errorf("unexpected trace fields: %v", trace)
+	}
+	if strings.Contains(trace["response_body"].(string), `"pw"`) {
+		t.Errorf("expected the response password to be redacted: %v", trace)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+// addAPIErrorDiagnostic reports a failed API call. detail describes the operation, e.g.
+// "Could not create role"; the error, with its HTTP status, endpoint and request ID, is
+// appended to it. Validation failures that name a request field known to the resource schema
+// are attached to that attribute, and conflicts and permission failures get a hint about
+// their likely cause.
+func addAPIErrorDiagnostic(ctx context.Context, diags *diag.Diagnostics, state tfsdk.State, summary, detail string, err error) {
+	message := detail + ": " + err.Error()
+	apiErr, ok := client.AsAPIError(err)
+	if !ok {
+		diags.AddError(summary, message)
+		return
+	}
+
+	switch {
+	case client.IsValidation(err):
+		if attrPath, found := apiErrorAttributePath(ctx, state, apiErr.Field); found {
+			diags.AddAttributeError(attrPath, summary, message)
+			return
+		}
+		diags.AddError(summary, message)
+	case client.IsConflict(err):
+		diags.AddError(summary, message+". An object with the same name may already exist in Galaxy; import it or choose a different name.")
+	case client.IsForbidden(err):
+		diags.AddError(summary, message+". The provider credentials are not allowed to perform this operation; check the roles granted to the service account.")
+	default:
+		diags.AddError(summary, message)
+	}
+}
+
+// apiErrorAttributePath maps a camelCase request field reported by Galaxy to the matching
+// top-level schema attribute.
+func apiErrorAttributePath(ctx context.Context, state tfsdk.State, field string) (path.Path, bool) {
+	if field == "" || state.Schema == nil {
+		return path.Empty(), false
+	}
+
+	attrPath := path.Root(camelToSnake(field))
+	if _, d := state.Schema.AttributeAtPath(ctx, attrPath); d.HasError() {
+		return path.Empty(), false
+	}
+	return attrPath, true
+}
+
+// camelToSnake converts a Galaxy API field name such as minWorkers to min_workers.
+func camelToSnake(s string) string {
+	var sb strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
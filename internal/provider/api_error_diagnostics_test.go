@@ -0,0 +1,96 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+func TestAddAPIErrorDiagnostic(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&sshTunnelResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+
+	cases := []struct {
+		name     string
+		err      error
+		wantPath path.Path
+		wantText string
+	}{
+		{
+			name:     "validation with known field",
+			err:      &client.APIError{StatusCode: http.StatusBadRequest, Message: "invalid port", Field: "sshTunnelPort", RequestID: "req-1", Method: http.MethodPost, Endpoint: "/public/api/v1/sshTunnel"},
+			wantPath: path.Root("ssh_tunnel_port"),
+			wantText: "Could not create ssh_tunnel: API request failed with status 400 on POST /public/api/v1/sshTunnel: invalid port [request ID: req-1]",
+		},
+		{
+			name:     "validation with unknown field",
+			err:      &client.APIError{StatusCode: http.StatusUnprocessableEntity, Message: "bad", Field: "somethingElse", Method: http.MethodPost, Endpoint: "/public/api/v1/sshTunnel"},
+			wantPath: path.Empty(),
+			wantText: "Could not create ssh_tunnel: API request failed with status 422 on POST /public/api/v1/sshTunnel: bad",
+		},
+		{
+			name:     "conflict",
+			err:      &client.APIError{StatusCode: http.StatusConflict, Message: "name taken", Method: http.MethodPost, Endpoint: "/public/api/v1/sshTunnel"},
+			wantPath: path.Empty(),
+			wantText: "status 409 on POST /public/api/v1/sshTunnel: name taken. An object with the same name may already exist in Galaxy; import it or choose a different name.",
+		},
+		{
+			name:     "forbidden",
+			err:      &client.APIError{StatusCode: http.StatusForbidden, Message: "denied", Method: http.MethodPost, Endpoint: "/public/api/v1/sshTunnel"},
+			wantPath: path.Empty(),
+			wantText: "status 403 on POST /public/api/v1/sshTunnel: denied. The provider credentials are not allowed to perform this operation; check the roles granted to the service account.",
+		},
+		{
+			name:     "plain error",
+			err:      errors.New("request failed: connection reset"),
+			wantPath: path.Empty(),
+			wantText: "Could not create ssh_tunnel: request failed: connection reset",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addAPIErrorDiagnostic(ctx, &diags, state, "Error creating ssh_tunnel", "Could not create ssh_tunnel", tc.err)
+			if len(diags) != 1 {
+				t.Fatalf("expected one diagnostic, got %v", diags)
+			}
+
+			var gotPath path.Path
+			if withPath, ok := diags[0].(diag.DiagnosticWithPath); ok {
+				gotPath = withPath.Path()
+			}
+			if gotPath.String() != tc.wantPath.String() {
+				t.Errorf("expected path %q, got %q", tc.wantPath, gotPath)
+			}
+			if !strings.Contains(diags[0].Detail(), tc.wantText) {
+				t.Errorf("expected detail to contain %q, got %q", tc.wantText, diags[0].Detail())
+			}
+		})
+	}
+}
+
+func TestCamelToSnake(t *testing.T) {
+	for in, want := range map[string]string{
+		"minWorkers":    "min_workers",
+		"name":          "name",
+		"sshTunnelPort": "ssh_tunnel_port",
+	} {
+		if got := camelToSnake(in); got != want {
+			t.Errorf("camelToSnake(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -115,10 +115,7 @@ func (r *bigquery_catalogResource) Create(ctx context.Context, req resource.Crea
 	tflog.Debug(ctx, "Creating bigquery_catalog")
 	response, err := r.client.CreateCatalog(ctx, "bigquery", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating bigquery_catalog",
-			"Could not create bigquery_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating bigquery_catalog", "Could not create bigquery_catalog", err)
 		return
 	}
 
@@ -196,10 +193,7 @@ func (r *bigquery_catalogResource) Update(ctx context.Context, req resource.Upda
 	tflog.Debug(ctx, "Updating bigquery_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "bigquery", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating bigquery_catalog",
-			"Could not update bigquery_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating bigquery_catalog", "Could not update bigquery_catalog "+id, err)
 		return
 	}
 
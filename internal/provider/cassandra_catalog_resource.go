@@ -111,10 +111,7 @@ func (r *cassandra_catalogResource) Create(ctx context.Context, req resource.Cre
 	tflog.Debug(ctx, "Creating cassandra_catalog")
 	response, err := r.client.CreateCatalog(ctx, "cassandra", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating cassandra_catalog",
-			"Could not create cassandra_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating cassandra_catalog", "Could not create cassandra_catalog", err)
 		return
 	}
 
@@ -192,10 +189,7 @@ func (r *cassandra_catalogResource) Update(ctx context.Context, req resource.Upd
 	tflog.Debug(ctx, "Updating cassandra_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "cassandra", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating cassandra_catalog",
-			"Could not update cassandra_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating cassandra_catalog", "Could not update cassandra_catalog "+id, err)
 		return
 	}
 
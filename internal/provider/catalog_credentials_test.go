@@ -0,0 +1,69 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_bigquery_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_cassandra_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_gcs_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_mongodb_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_mysql_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_opensearch_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_postgresql_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_redshift_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_s3_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_snowflake_catalog"
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_sqlserver_catalog"
+)
+
+// TestCatalogCredentialsWriteOnly checks that every catalog credential is write-only and has a
+// companion _version attribute that can be bumped to send an updated value.
+func TestCatalogCredentialsWriteOnly(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name        string
+		schema      schema.Schema
+		credentials []string
+	}{
+		{"bigquery", resource_bigquery_catalog.BigqueryCatalogResourceSchema(ctx), []string{"credentials_key"}},
+		{"cassandra", resource_cassandra_catalog.CassandraCatalogResourceSchema(ctx), []string{"password", "token"}},
+		{"gcs", resource_gcs_catalog.GcsCatalogResourceSchema(ctx), []string{"credentials_key"}},
+		{"mongodb", resource_mongodb_catalog.MongodbCatalogResourceSchema(ctx), []string{"password"}},
+		{"mysql", resource_mysql_catalog.MysqlCatalogResourceSchema(ctx), []string{"password"}},
+		{"opensearch", resource_opensearch_catalog.OpensearchCatalogResourceSchema(ctx), []string{"access_key", "password", "secret_key"}},
+		{"postgresql", resource_postgresql_catalog.PostgresqlCatalogResourceSchema(ctx), []string{"password"}},
+		{"redshift", resource_redshift_catalog.RedshiftCatalogResourceSchema(ctx), []string{"access_key", "password", "secret_key"}},
+		{"s3", resource_s3_catalog.S3CatalogResourceSchema(ctx), []string{"access_key", "glue_secret_key", "secret_key"}},
+		{"snowflake", resource_snowflake_catalog.SnowflakeCatalogResourceSchema(ctx), []string{"password", "private_key", "private_key_passphrase"}},
+		{"sqlserver", resource_sqlserver_catalog.SqlserverCatalogResourceSchema(ctx), []string{"password"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, name := range tc.credentials {
+				attr, ok := tc.schema.Attributes[name].(schema.StringAttribute)
+				if !ok {
+					t.Errorf("%s: expected string attribute", name)
+					continue
+				}
+				if !attr.WriteOnly || !attr.Sensitive || attr.Computed {
+					t.Errorf("%s: expected sensitive, write-only, non-computed attribute", name)
+				}
+
+				version, ok := tc.schema.Attributes[name+"_version"].(schema.Int64Attribute)
+				if !ok {
+					t.Errorf("%s_version: expected int64 attribute", name)
+					continue
+				}
+				if !version.Optional || version.WriteOnly {
+					t.Errorf("%s_version: expected optional attribute persisted in state", name)
+				}
+			}
+		})
+	}
+}
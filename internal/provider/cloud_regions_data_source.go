@@ -0,0 +1,181 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*cloudRegionsDataSource)(nil)
+
+func NewCloudRegionsDataSource() datasource.DataSource {
+	return &cloudRegionsDataSource{}
+}
+
+// cloudRegionsDataSource looks up Galaxy cloud region IDs. The Galaxy API has no endpoint
+// listing regions, so it reads the built-in galaxyCloudRegions table and needs no client. The
+// table is maintained by hand and may miss regions Galaxy has added since this release.
+type cloudRegionsDataSource struct{}
+
+type cloudRegionsModel struct {
+	Cloud        types.String       `tfsdk:"cloud"`
+	Region       types.String       `tfsdk:"region"`
+	CloudRegions []cloudRegionModel `tfsdk:"cloud_regions"`
+}
+
+type cloudRegionModel struct {
+	CloudRegionId types.String `tfsdk:"cloud_region_id"`
+	Cloud         types.String `tfsdk:"cloud"`
+	Region        types.String `tfsdk:"region"`
+}
+
+// cloudRegion is a region in which Galaxy can run clusters.
+type cloudRegion struct {
+	cloud  string
+	region string
+}
+
+// id returns the Galaxy cloud region ID: the cloud followed by the region name, with the last
+// hyphen of AWS region names dropped, for example aws-us-east1 for us-east-1.
+func (r cloudRegion) id() string {
+	region := r.region
+	if i := strings.LastIndex(region, "-"); i >= 0 && r.cloud == "aws" {
+		region = region[:i] + region[i+1:]
+	}
+	return r.cloud + "-" + region
+}
+
+// galaxyCloudRegions lists the regions Galaxy supports, by cloud provider region name. It is
+// not synced with the API: galaxy_cluster only warns about regions missing from it.
+var galaxyCloudRegions = []cloudRegion{
+	{"aws", "us-east-1"},
+	{"aws", "us-east-2"},
+	{"aws", "us-west-2"},
+	{"aws", "ca-central-1"},
+	{"aws", "sa-east-1"},
+	{"aws", "eu-central-1"},
+	{"aws", "eu-west-1"},
+	{"aws", "eu-west-2"},
+	{"aws", "eu-north-1"},
+	{"aws", "ap-south-1"},
+	{"aws", "ap-southeast-1"},
+	{"aws", "ap-southeast-2"},
+	{"aws", "ap-northeast-1"},
+	{"aws", "ap-northeast-2"},
+	{"gcp", "us-central1"},
+	{"gcp", "us-east1"},
+	{"gcp", "us-east4"},
+	{"gcp", "us-west1"},
+	{"gcp", "northamerica-northeast1"},
+	{"gcp", "europe-west1"},
+	{"gcp", "europe-west2"},
+	{"gcp", "europe-west3"},
+	{"gcp", "asia-northeast1"},
+	{"gcp", "asia-southeast1"},
+	{"gcp", "australia-southeast1"},
+	{"azure", "eastus"},
+	{"azure", "eastus2"},
+	{"azure", "centralus"},
+	{"azure", "westus2"},
+	{"azure", "canadacentral"},
+	{"azure", "northeurope"},
+	{"azure", "westeurope"},
+	{"azure", "uksouth"},
+	{"azure", "germanywestcentral"},
+	{"azure", "centralindia"},
+	{"azure", "southeastasia"},
+	{"azure", "japaneast"},
+	{"azure", "australiaeast"},
+}
+
+// isKnownCloudRegionID reports whether id is one of galaxyCloudRegions.
+func isKnownCloudRegionID(id string) bool {
+	for _, r := range galaxyCloudRegions {
+		if r.id() == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *cloudRegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_regions"
+}
+
+func (d *cloudRegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up Galaxy cloud region IDs, optionally filtered by cloud and region name, for use as cloud_region_id. The list is built into the provider rather than read from the Galaxy API, so it may be incomplete: regions added to Galaxy after this provider release are missing until the provider is updated, and can still be used by ID.",
+		Attributes: map[string]schema.Attribute{
+			"cloud": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return regions of this cloud: aws, gcp or azure.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("aws", "gcp", "azure"),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the region with this cloud provider name, for example us-east-1 or westeurope.",
+			},
+			"cloud_regions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching cloud regions.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_region_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Galaxy cloud region ID, for example aws-us-east1.",
+						},
+						"cloud": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud provider: aws, gcp or azure.",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud provider region name, for example us-east-1.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *cloudRegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cloudRegionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.CloudRegions = []cloudRegionModel{}
+	for _, r := range galaxyCloudRegions {
+		if !config.Cloud.IsNull() && config.Cloud.ValueString() != r.cloud {
+			continue
+		}
+		if !config.Region.IsNull() && config.Region.ValueString() != r.region {
+			continue
+		}
+		config.CloudRegions = append(config.CloudRegions, cloudRegionModel{
+			CloudRegionId: types.StringValue(r.id()),
+			Cloud:         types.StringValue(r.cloud),
+			Region:        types.StringValue(r.region),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
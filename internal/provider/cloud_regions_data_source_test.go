@@ -0,0 +1,31 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestCloudRegionID(t *testing.T) {
+	tests := map[cloudRegion]string{
+		{"aws", "us-east-1"}:  "aws-us-east1",
+		{"gcp", "us-east1"}:   "gcp-us-east1",
+		{"azure", "eastus2"}:  "azure-eastus2",
+		{"aws", "eu-north-1"}: "aws-eu-north1",
+	}
+	for region, want := range tests {
+		if got := region.id(); got != want {
+			t.Errorf("%s %s: id() = %q, want %q", region.cloud, region.region, got, want)
+		}
+		if !isKnownCloudRegionID(want) {
+			t.Errorf("%s should be a known region", want)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, region := range galaxyCloudRegions {
+		if seen[region.id()] {
+			t.Errorf("duplicate region %s", region.id())
+		}
+		seen[region.id()] = true
+	}
+}
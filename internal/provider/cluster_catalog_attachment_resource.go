@@ -0,0 +1,326 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*clusterCatalogAttachmentResource)(nil)
+var _ resource.ResourceWithConfigure = (*clusterCatalogAttachmentResource)(nil)
+var _ resource.ResourceWithImportState = (*clusterCatalogAttachmentResource)(nil)
+
+func NewClusterCatalogAttachmentResource() resource.Resource {
+	return &clusterCatalogAttachmentResource{}
+}
+
+type clusterCatalogAttachmentResource struct {
+	client *client.GalaxyClient
+}
+
+type clusterCatalogAttachmentModel struct {
+	ClusterId types.String   `tfsdk:"cluster_id"`
+	CatalogId types.String   `tfsdk:"catalog_id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *clusterCatalogAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_catalog_attachment"
+}
+
+func (r *clusterCatalogAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a single catalog to a cluster. This is a non-authoritative resource: other catalogs on the same cluster are left untouched. Do not list the same catalog in the catalog_refs of the galaxy_cluster resource.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalog_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the catalog to attach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for a running cluster to return to RUNNING after the catalog is attached. Defaults to 10m.",
+				Delete:            true,
+				DeleteDescription: "How long to wait for a running cluster to return to RUNNING after the catalog is detached. Defaults to 10m.",
+			}),
+		},
+	}
+}
+
+func (r *clusterCatalogAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *clusterCatalogAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clusterCatalogAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := plan.ClusterId.ValueString()
+	catalogID := plan.CatalogId.ValueString()
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, clusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating cluster_catalog_attachment", map[string]interface{}{
+		"cluster_id": clusterID,
+		"catalog_id": catalogID,
+	})
+
+	// Serialize read-modify-write to prevent concurrent updates from dropping catalogs
+	r.client.LockCluster(clusterID)
+
+	clusterResp, err := r.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		r.client.UnlockCluster(clusterID)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error reading cluster", "Could not read current catalogs of cluster "+clusterID, err)
+		return
+	}
+
+	catalogRefs := clusterCatalogRefs(clusterResp)
+	if slices.Contains(catalogRefs, catalogID) {
+		r.client.UnlockCluster(clusterID)
+		resp.Diagnostics.AddError(
+			"Duplicate catalog attachment",
+			fmt.Sprintf("Catalog %s is already attached to cluster %s. Import it with: terraform import <address> %s/%s",
+				catalogID, clusterID, clusterID, catalogID),
+		)
+		return
+	}
+
+	// PATCH the full set with the new catalog appended
+	clusterResp, err = r.client.UpdateCluster(ctx, clusterID, map[string]interface{}{"catalogRefs": append(catalogRefs, catalogID)})
+	r.client.UnlockCluster(clusterID)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating cluster catalog attachment", "Could not update catalogs of cluster "+clusterID, err)
+		return
+	}
+
+	r.waitForCluster(ctx, clusterID, clusterResp, createTimeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterCatalogAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state clusterCatalogAttachmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := state.ClusterId.ValueString()
+	catalogID := state.CatalogId.ValueString()
+
+	tflog.Debug(ctx, "Reading cluster_catalog_attachment", map[string]interface{}{
+		"cluster_id": clusterID,
+		"catalog_id": catalogID,
+	})
+
+	clusterResp, err := r.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Cluster not found, removing cluster_catalog_attachment from state", map[string]interface{}{"cluster_id": clusterID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading cluster",
+			"Could not read catalogs of cluster "+clusterID+": "+err.Error(),
+		)
+		return
+	}
+
+	if !slices.Contains(clusterCatalogRefs(clusterResp), catalogID) {
+		tflog.Warn(ctx, "Catalog attachment not found, removing from state", map[string]interface{}{
+			"cluster_id": clusterID,
+			"catalog_id": catalogID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *clusterCatalogAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes use RequiresReplace, so Update should never be called.
+	// If it is, just persist the plan.
+	var plan clusterCatalogAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterCatalogAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state clusterCatalogAttachmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := state.ClusterId.ValueString()
+	catalogID := state.CatalogId.ValueString()
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, clusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting cluster_catalog_attachment", map[string]interface{}{
+		"cluster_id": clusterID,
+		"catalog_id": catalogID,
+	})
+
+	// Serialize read-modify-write to prevent concurrent updates from dropping catalogs
+	r.client.LockCluster(clusterID)
+
+	clusterResp, err := r.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		r.client.UnlockCluster(clusterID)
+		if client.IsNotFound(err) {
+			return
+		}
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, req.State, "Error reading cluster", "Could not read current catalogs of cluster "+clusterID, err)
+		return
+	}
+
+	catalogRefs := clusterCatalogRefs(clusterResp)
+	if !slices.Contains(catalogRefs, catalogID) {
+		r.client.UnlockCluster(clusterID)
+		return
+	}
+
+	// PATCH the filtered set
+	remaining := slices.DeleteFunc(catalogRefs, func(ref string) bool { return ref == catalogID })
+	clusterResp, err = r.client.UpdateCluster(ctx, clusterID, map[string]interface{}{"catalogRefs": remaining})
+	r.client.UnlockCluster(clusterID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Cluster not found during cluster_catalog_attachment delete; treating as already deleted", map[string]interface{}{
+				"cluster_id": clusterID,
+				"catalog_id": catalogID,
+			})
+			return
+		}
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, req.State, "Error deleting cluster catalog attachment", "Could not update catalogs of cluster "+clusterID, err)
+		return
+	}
+
+	r.waitForCluster(ctx, clusterID, clusterResp, deleteTimeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted cluster_catalog_attachment", map[string]interface{}{
+		"cluster_id": clusterID,
+		"catalog_id": catalogID,
+	})
+}
+
+// waitForCluster waits for a running cluster to come back after the restart caused by a
+// catalog change, like galaxy_cluster does, so dependent resources can query the catalog.
+func (r *clusterCatalogAttachmentResource) waitForCluster(ctx context.Context, clusterID string, clusterResp map[string]interface{}, timeout time.Duration, diags *diag.Diagnostics) {
+	if !clusterIsActive(clusterResp) {
+		return
+	}
+
+	clusters := &clusterResource{client: r.client}
+	if _, err := clusters.waitForClusterRunning(ctx, clusterID, timeout); err != nil {
+		diags.AddError(
+			"Error waiting for cluster to become ready",
+			err.Error(),
+		)
+	}
+}
+
+func (r *clusterCatalogAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterID, catalogID, ok := strings.Cut(req.ID, "/")
+	if !ok || clusterID == "" || catalogID == "" || strings.Contains(catalogID, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in the format cluster_id/catalog_id",
+		)
+		return
+	}
+
+	// Read from API to confirm the attachment
+	clusterResp, err := r.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing cluster catalog attachment",
+			"Could not read cluster "+clusterID+": "+err.Error(),
+		)
+		return
+	}
+
+	if !slices.Contains(clusterCatalogRefs(clusterResp), catalogID) {
+		resp.Diagnostics.AddError(
+			"Catalog attachment not found",
+			fmt.Sprintf("Catalog %s is not attached to cluster %s.", catalogID, clusterID),
+		)
+		return
+	}
+
+	// Set the IDs individually so the timeouts block stays null
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("catalog_id"), catalogID)...)
+}
@@ -0,0 +1,29 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestClusterCatalogAttachmentWaitUsesTimeout(t *testing.T) {
+	clusters := newFakeClusterAPI(t, map[string]interface{}{"clusterId": "c-1", "clusterState": "STARTING"})
+	r := &clusterCatalogAttachmentResource{client: clusters.client}
+
+	var diags diag.Diagnostics
+	start := time.Now()
+	r.waitForCluster(context.Background(), "c-1", map[string]interface{}{"clusterState": "STARTING"}, 50*time.Millisecond, &diags)
+
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "before timeout") {
+		t.Fatalf("expected a timeout error, got %v", diags)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the wait to stop at the configured timeout, took %v", elapsed)
+	}
+}
@@ -11,10 +11,12 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,6 +34,8 @@ import (
 
 var _ resource.Resource = (*clusterResource)(nil)
 var _ resource.ResourceWithImportState = (*clusterResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*clusterResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*clusterResource)(nil)
 
 func NewClusterResource() resource.Resource {
 	return &clusterResource{}
@@ -39,6 +43,15 @@ func NewClusterResource() resource.Resource {
 
 type clusterResource struct {
 	client *client.GalaxyClient
+
+	// pollInterval overrides clusterPollInterval in tests
+	pollInterval time.Duration
+}
+
+// ClusterModelExtended adds the timeouts block to the generated cluster model
+type ClusterModelExtended struct {
+	resource_cluster.ClusterModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *clusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -77,6 +90,42 @@ func (r *clusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 		s.Attributes["warp_resiliency_enabled"] = attr
 	}
 
+	// enabled is computed-only in the generated schema. Make it configurable so clusters can be
+	// kept stopped declaratively. When omitted, new clusters are started and existing clusters keep
+	// whatever state Galaxy reports.
+	if attr, ok := s.Attributes["enabled"].(schema.BoolAttribute); ok {
+		attr.Optional = true
+		attr.Computed = true
+		attr.Description = "Whether the cluster is enabled. Set to false to stop the cluster and keep it stopped. When omitted, new clusters are started and the enabled state of existing clusters is left unchanged."
+		attr.MarkdownDescription = "Whether the cluster is enabled. Set to `false` to stop the cluster and keep it stopped. When omitted, new clusters are started and the enabled state of existing clusters is left unchanged."
+		attr.PlanModifiers = []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		}
+		s.Attributes["enabled"] = attr
+	}
+
+	// catalog_refs is required in the generated schema, which forced every configuration to own the
+	// full catalog list. It only lists the catalogs this resource manages; others, such as those
+	// attached with galaxy_cluster_catalog_attachment, are left alone.
+	if attr, ok := s.Attributes["catalog_refs"].(schema.ListAttribute); ok {
+		attr.Required = false
+		attr.Optional = true
+		attr.Description = "Catalog IDs to attach to the cluster. Catalogs attached by other means, such as galaxy_cluster_catalog_attachment, are neither removed nor reported. Omit to leave all catalog attachments to other resources. An imported cluster manages none of its catalogs until the first apply adopts those listed here."
+		attr.MarkdownDescription = "Catalog IDs to attach to the cluster. Catalogs attached by other means, such as `galaxy_cluster_catalog_attachment`, are neither removed nor reported. Omit to leave all catalog attachments to other resources. An imported cluster manages none of its catalogs until the first apply adopts those listed here."
+		s.Attributes["catalog_refs"] = attr
+	}
+
+	s.Blocks = map[string]schema.Block{
+		"timeouts": timeouts.Block(ctx, timeouts.Opts{
+			Create:            true,
+			CreateDescription: "How long to wait for a new cluster to reach RUNNING. Defaults to 10m.",
+			Update:            true,
+			UpdateDescription: "How long to wait for a resized cluster, or one whose catalogs changed, to return to RUNNING. Defaults to 10m.",
+			Delete:            true,
+			DeleteDescription: "How long to wait for a deleted cluster to disappear. Defaults to 10m.",
+		}),
+	}
+
 	resp.Schema = s
 }
 
@@ -97,8 +146,96 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+// ValidateConfig rejects combinations the Galaxy API would only reject at apply time, after a
+// rate-limited create or update call.
+func (r *clusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ClusterModelExtended
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateClusterConfig(&config.ClusterModel, &resp.Diagnostics)
+}
+
+// ModifyPlan repeats the ValidateConfig checks against the planned values, so references that
+// were unknown during validation and omitted attributes that keep their prior value, such as
+// processing_mode, are still checked before the apply. Diagnostics ValidateConfig already
+// reported for the configuration are not repeated.
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan ClusterModelExtended
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planOnlyClusterDiagnostics(&config.ClusterModel, &plan.ClusterModel)...)
+}
+
+// planOnlyClusterDiagnostics returns the validateClusterConfig diagnostics for plan that the
+// configuration alone did not produce.
+func planOnlyClusterDiagnostics(config, plan *resource_cluster.ClusterModel) diag.Diagnostics {
+	var configDiags, planDiags, diags diag.Diagnostics
+	validateClusterConfig(config, &configDiags)
+	validateClusterConfig(plan, &planDiags)
+	for _, d := range planDiags {
+		if !configDiags.Contains(d) {
+			diags.Append(d)
+		}
+	}
+	return diags
+}
+
+func validateClusterConfig(config *resource_cluster.ClusterModel, diags *diag.Diagnostics) {
+	if !config.MinWorkers.IsNull() && !config.MinWorkers.IsUnknown() &&
+		!config.MaxWorkers.IsNull() && !config.MaxWorkers.IsUnknown() &&
+		config.MinWorkers.ValueInt64() > config.MaxWorkers.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("min_workers"),
+			"Invalid Worker Range",
+			fmt.Sprintf("min_workers (%d) must not be greater than max_workers (%d).", config.MinWorkers.ValueInt64(), config.MaxWorkers.ValueInt64()),
+		)
+	}
+
+	// processing_mode may be omitted, leaving it to Galaxy, or unknown until apply; only a known
+	// non-Batch mode is rejected.
+	if !config.Replicas.IsNull() && !config.Replicas.IsUnknown() && config.Replicas.ValueInt64() > 0 &&
+		!config.ProcessingMode.IsNull() && !config.ProcessingMode.IsUnknown() && config.ProcessingMode.ValueString() != "Batch" {
+		diags.AddAttributeError(
+			path.Root("replicas"),
+			"Replicas Require Batch Processing Mode",
+			"replicas can only be set when processing_mode is \"Batch\".",
+		)
+	}
+
+	if !config.ResultCacheDefaultVisibilitySeconds.IsNull() && !config.ResultCacheDefaultVisibilitySeconds.IsUnknown() &&
+		!config.ResultCacheEnabled.IsNull() && !config.ResultCacheEnabled.IsUnknown() && !config.ResultCacheEnabled.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("result_cache_default_visibility_seconds"),
+			"Result Cache Disabled",
+			"result_cache_default_visibility_seconds can only be set when result_cache_enabled is true.",
+		)
+	}
+
+	// The region table is built into the provider and may lag behind Galaxy, so an unlisted
+	// region only warns; the API remains the authority.
+	if !config.CloudRegionId.IsNull() && !config.CloudRegionId.IsUnknown() && !isKnownCloudRegionID(config.CloudRegionId.ValueString()) {
+		diags.AddAttributeWarning(
+			path.Root("cloud_region_id"),
+			"Unknown Cloud Region",
+			fmt.Sprintf("%q is not in the provider's list of Galaxy cloud regions. Check for a typo; if the region is new to Galaxy, the apply will still succeed. The galaxy_cloud_regions data source looks up region IDs by cloud and region name.", config.CloudRegionId.ValueString()),
+		)
+	}
+}
+
 func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_cluster.ClusterModel
+	var plan ClusterModelExtended
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -107,14 +244,15 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Convert plan to API request
-	clusterRequest := r.modelToCreateRequest(ctx, &plan, &resp.Diagnostics)
+	clusterRequest := r.modelToCreateRequest(ctx, &plan.ClusterModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Debug log the full request
-	if debugJSON, err := json.Marshal(clusterRequest); err == nil {
-		tflog.Info(ctx, "Creating cluster with request: "+string(debugJSON))
+	createTimeout, diags := plan.Timeouts.Create(ctx, clusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	tflog.Debug(ctx, "Creating cluster", map[string]interface{}{
@@ -124,10 +262,7 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create cluster via API
 	clusterResp, err := r.client.CreateCluster(ctx, clusterRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating cluster",
-			"Could not create cluster, unexpected error: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating cluster", "Could not create cluster, unexpected error", err)
 		return
 	}
 
@@ -142,13 +277,16 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 		)
 		return
 	}
-	_, err = r.client.UpdateCluster(ctx, clusterID, map[string]interface{}{"enabled": true})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error enabling cluster",
-			"Could not enable cluster "+clusterID+": "+err.Error(),
-		)
-		return
+	enable := plan.Enabled.IsNull() || plan.Enabled.IsUnknown() || plan.Enabled.ValueBool()
+	if enable {
+		_, err = r.client.UpdateCluster(ctx, clusterID, map[string]interface{}{"enabled": true})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error enabling cluster",
+				"Could not enable cluster "+clusterID+": "+err.Error(),
+			)
+			return
+		}
 	}
 
 	// Save cluster ID to state immediately after creation, before polling begins.
@@ -165,17 +303,21 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	// cluster to finish provisioning. Resources that depend on this cluster (e.g. data quality
 	// checks that run EXPLAIN against it) need the cluster in RUNNING state or the Galaxy API
 	// rejects them with "Cluster is disabled. Cannot execute queries on disabled cluster."
-	clusterResp, err = r.waitForClusterRunning(ctx, clusterID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error waiting for cluster to become ready",
-			err.Error(),
-		)
-		return
+	// A cluster created with enabled = false stays in its initial state, so there is nothing
+	// to wait for.
+	if enable {
+		clusterResp, err = r.waitForClusterRunning(ctx, clusterID, createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for cluster to become ready",
+				err.Error(),
+			)
+			return
+		}
 	}
 
 	// Update plan with response data
-	r.updateModelFromResponse(ctx, &plan, clusterResp, &resp.Diagnostics)
+	r.updateModelFromResponse(ctx, &plan.ClusterModel, clusterResp, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -189,56 +331,155 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 const (
-	clusterReadyPollInterval = 5 * time.Second
+	clusterPollInterval = 5 * time.Second
 	// Observed real-world startup time is ~80-90s; 10 minutes gives generous margin for variance.
-	clusterReadyTimeout = 10 * time.Minute
+	// Large warp speed clusters can take longer; the timeouts block overrides this per operation.
+	clusterDefaultTimeout = 10 * time.Minute
 )
 
-// clusterTerminalFailureStates lists cluster states that cannot transition to RUNNING.
+// clusterTerminalFailureStates lists cluster states that cannot transition to RUNNING or STOPPED.
 // Polling should fail fast when the cluster lands in one of these instead of waiting for timeout.
 var clusterTerminalFailureStates = map[string]struct{}{
 	"FAILED":      {},
 	"TERMINATED":  {},
 	"TERMINATING": {},
 	"ERROR":       {},
-	"STOPPED":     {},
-	"STOPPING":    {},
+}
+
+// clusterStoppedStates lists the states of a cluster that is shutting down or shut down. They
+// are the goal when the user disabled the cluster, and a failure when waiting for RUNNING.
+var clusterStoppedStates = map[string]struct{}{
+	"STOPPED":  {},
+	"STOPPING": {},
 }
 
 // waitForClusterRunning polls the cluster until it reaches RUNNING state, since only that
 // state guarantees the cluster has a live Trino URI that can serve queries. Returns early
-// if the cluster enters a terminal non-running state (e.g. FAILED, TERMINATED).
-func (r *clusterResource) waitForClusterRunning(ctx context.Context, clusterID string) (map[string]interface{}, error) {
-	ctx, cancel := context.WithTimeout(ctx, clusterReadyTimeout)
+// if the cluster enters a terminal non-running state (e.g. FAILED, TERMINATED, STOPPED).
+func (r *clusterResource) waitForClusterRunning(ctx context.Context, clusterID string, timeout time.Duration) (map[string]interface{}, error) {
+	return r.waitForClusterState(ctx, clusterID, "RUNNING", false, timeout)
+}
+
+// waitForClusterStarted is waitForClusterRunning for a cluster that was just re-enabled. The
+// cluster may still report STOPPED until the start begins, so that state only counts as a
+// failure once the cluster has left it.
+func (r *clusterResource) waitForClusterStarted(ctx context.Context, clusterID string, timeout time.Duration) (map[string]interface{}, error) {
+	return r.waitForClusterState(ctx, clusterID, "RUNNING", true, timeout)
+}
+
+// waitForClusterStopped polls a disabled cluster until it reaches STOPPED state, so that
+// a plan that stops a cluster only completes once it no longer runs (or bills for) workers.
+func (r *clusterResource) waitForClusterStopped(ctx context.Context, clusterID string, timeout time.Duration) (map[string]interface{}, error) {
+	return r.waitForClusterState(ctx, clusterID, "STOPPED", false, timeout)
+}
+
+func (r *clusterResource) waitForClusterState(ctx context.Context, clusterID, target string, fromStopped bool, timeout time.Duration) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	ticker := time.NewTicker(clusterReadyPollInterval)
+	ticker := time.NewTicker(r.clusterPollInterval())
 	defer ticker.Stop()
 
+	state := ""
 	for {
 		clusterResp, err := r.client.GetCluster(ctx, clusterID)
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("cluster %s did not reach %s state before timeout (last state: %s)", clusterID, target, state)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("could not get cluster %s: %w", clusterID, err)
 		}
 
-		state, _ := clusterResp["clusterState"].(string)
-		if state == "RUNNING" {
+		state, _ = clusterResp["clusterState"].(string)
+		if state == target {
 			return clusterResp, nil
 		}
-		if _, terminal := clusterTerminalFailureStates[state]; terminal {
-			return nil, fmt.Errorf("cluster %s entered terminal state %s and cannot reach RUNNING", clusterID, state)
+		_, terminal := clusterTerminalFailureStates[state]
+		_, stopped := clusterStoppedStates[state]
+		if stopped && target == "RUNNING" && !fromStopped {
+			terminal = true
+		}
+		if !stopped {
+			fromStopped = false
+		}
+		if terminal {
+			return nil, fmt.Errorf("cluster %s entered terminal state %s and cannot reach %s", clusterID, state, target)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("cluster %s did not reach %s state before timeout (last state: %s)", clusterID, target, state)
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForClusterDeleted polls the cluster until the API no longer returns it, so that
+// resources recreated with the same name or depending on the deletion don't race it.
+func (r *clusterResource) waitForClusterDeleted(ctx context.Context, clusterID string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(r.clusterPollInterval())
+	defer ticker.Stop()
+
+	state := ""
+	for {
+		clusterResp, err := r.client.GetCluster(ctx, clusterID)
+		if client.IsNotFound(err) {
+			return nil
+		}
+		if err == nil {
+			state, _ = clusterResp["clusterState"].(string)
+		} else if ctx.Err() == nil && !errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("could not get cluster %s: %w", clusterID, err)
 		}
 
 		select {
 		case <-ctx.Done():
-			return nil, fmt.Errorf("cluster %s did not reach RUNNING state before timeout (last state: %s)", clusterID, state)
+			return fmt.Errorf("cluster %s was not deleted before timeout (last state: %s)", clusterID, state)
 		case <-ticker.C:
 		}
 	}
 }
 
+func (r *clusterResource) clusterPollInterval() time.Duration {
+	if r.pollInterval > 0 {
+		return r.pollInterval
+	}
+	return clusterPollInterval
+}
+
+// clusterIsActive reports whether a cluster is enabled and not stopped or stopping, i.e.
+// whether it is expected to return to RUNNING on its own.
+func clusterIsActive(clusterResp map[string]interface{}) bool {
+	if enabled, ok := clusterResp["enabled"].(bool); ok && !enabled {
+		return false
+	}
+	state, _ := clusterResp["clusterState"].(string)
+	return state != "STOPPED" && state != "STOPPING"
+}
+
+// clusterEnabledChanged reports whether an update starts or stops the cluster.
+func clusterEnabledChanged(plan, state *resource_cluster.ClusterModel) bool {
+	if plan.Enabled.IsNull() || plan.Enabled.IsUnknown() {
+		return false
+	}
+	return !plan.Enabled.Equal(state.Enabled)
+}
+
+// clusterNeedsRestartWait reports whether an update changes the cluster's size or catalogs,
+// after which Galaxy restarts the cluster and it is briefly unable to serve queries.
+func clusterNeedsRestartWait(plan, state *resource_cluster.ClusterModel) bool {
+	return !plan.MinWorkers.Equal(state.MinWorkers) ||
+		!plan.MaxWorkers.Equal(state.MaxWorkers) ||
+		!plan.Replicas.Equal(state.Replicas) ||
+		!plan.ProcessingMode.Equal(state.ProcessingMode) ||
+		!plan.CatalogRefs.Equal(state.CatalogRefs)
+}
+
 func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_cluster.ClusterModel
+	var state ClusterModelExtended
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -271,7 +512,7 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Update state with response data - for Read operation, use the standard update
-	r.updateModelFromResponse(ctx, &state, clusterResp, &resp.Diagnostics)
+	r.updateModelFromResponse(ctx, &state.ClusterModel, clusterResp, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -281,8 +522,8 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan resource_cluster.ClusterModel
-	var state resource_cluster.ClusterModel
+	var plan ClusterModelExtended
+	var state ClusterModelExtended
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -299,7 +540,13 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	clusterID := state.ClusterId.ValueString()
 
 	// Convert plan to API request
-	updateRequest := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
+	updateRequest := r.modelToUpdateRequest(ctx, &plan.ClusterModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, clusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -308,18 +555,73 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		"cluster_id": clusterID,
 	})
 
-	// Update cluster via API
-	clusterResp, err := r.client.UpdateCluster(ctx, clusterID, updateRequest)
+	// Other resources may attach catalogs to this cluster. Add and remove only the catalogs this
+	// resource manages and keep the rest, holding the cluster lock so that concurrent attachments
+	// are not lost between the read and the PATCH.
+	planned, diags := stringListElements(ctx, plan.CatalogRefs)
+	resp.Diagnostics.Append(diags...)
+	previous, diags := stringListElements(ctx, state.CatalogRefs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.LockCluster(clusterID)
+	currentResp, err := r.client.GetCluster(ctx, clusterID)
 	if err != nil {
+		r.client.UnlockCluster(clusterID)
 		resp.Diagnostics.AddError(
-			"Error updating cluster",
-			"Could not update cluster "+clusterID+": "+err.Error(),
+			"Error reading cluster",
+			"Could not read current catalogs of cluster "+clusterID+": "+err.Error(),
 		)
 		return
 	}
+	updateRequest["catalogRefs"] = mergeCatalogRefs(clusterCatalogRefs(currentResp), previous, planned)
+
+	// Update cluster via API
+	clusterResp, err := r.client.UpdateCluster(ctx, clusterID, updateRequest)
+	r.client.UnlockCluster(clusterID)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating cluster", "Could not update cluster "+clusterID, err)
+		return
+	}
+
+	// Starting or stopping the cluster completes once it reaches the matching state. Otherwise,
+	// a resize or catalog change restarts a running cluster. Wait for it to come back so
+	// dependent resources that run queries don't hit it mid-restart. A stopped cluster stays
+	// stopped, so there is nothing to wait for.
+	switch {
+	case clusterEnabledChanged(&plan.ClusterModel, &state.ClusterModel) && !plan.Enabled.ValueBool():
+		clusterResp, err = r.waitForClusterStopped(ctx, clusterID, updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for cluster to stop",
+				err.Error(),
+			)
+			return
+		}
+	case clusterEnabledChanged(&plan.ClusterModel, &state.ClusterModel):
+		clusterResp, err = r.waitForClusterStarted(ctx, clusterID, updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for cluster to become ready",
+				err.Error(),
+			)
+			return
+		}
+	case clusterNeedsRestartWait(&plan.ClusterModel, &state.ClusterModel) && clusterIsActive(clusterResp):
+		clusterResp, err = r.waitForClusterRunning(ctx, clusterID, updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for cluster to become ready",
+				err.Error(),
+			)
+			return
+		}
+	}
 
 	// Update plan with response data
-	r.updateModelFromResponse(ctx, &plan, clusterResp, &resp.Diagnostics)
+	r.updateModelFromResponse(ctx, &plan.ClusterModel, clusterResp, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -333,7 +635,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_cluster.ClusterModel
+	var state ClusterModelExtended
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -343,6 +645,12 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	clusterID := state.ClusterId.ValueString()
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, clusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting cluster", map[string]interface{}{
 		"cluster_id": clusterID,
 	})
@@ -359,15 +667,54 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 		}
 	}
 
+	// Galaxy tears clusters down asynchronously; wait until it is gone.
+	if err := r.waitForClusterDeleted(ctx, clusterID, deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for cluster deletion",
+			err.Error(),
+		)
+		return
+	}
+
 	tflog.Debug(ctx, "Deleted cluster", map[string]interface{}{
 		"cluster_id": clusterID,
 	})
 }
 
 func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// catalog_refs is left null, so an imported cluster manages no catalogs until the first apply
+	// adopts those in the configuration. Seeding it with every attached catalog would take over
+	// catalogs owned by galaxy_cluster_catalog_attachment and detach them on that apply.
 	resource.ImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
 }
 
+// clusterCatalogRefs returns the IDs of the catalogs attached to a cluster.
+func clusterCatalogRefs(clusterResp map[string]interface{}) []string {
+	catalogRefs, _ := clusterResp["catalogRefs"].([]interface{})
+	refs := make([]string, 0, len(catalogRefs))
+	for _, ref := range catalogRefs {
+		if refStr, ok := ref.(string); ok {
+			refs = append(refs, refStr)
+		}
+	}
+	return refs
+}
+
+// mergeCatalogRefs returns the catalogs to attach when a cluster's managed catalogs change from
+// previous to planned: the planned catalogs, followed by the current catalogs it never managed.
+func mergeCatalogRefs(current, previous, planned []string) []string {
+	merged := slices.Clone(planned)
+	if merged == nil {
+		merged = []string{}
+	}
+	for _, ref := range current {
+		if !slices.Contains(previous, ref) && !slices.Contains(planned, ref) {
+			merged = append(merged, ref)
+		}
+	}
+	return merged
+}
+
 // modelToCreateRequest converts the Terraform model to API create request
 func (r *clusterResource) modelToCreateRequest(ctx context.Context, model *resource_cluster.ClusterModel, diags *diag.Diagnostics) map[string]interface{} {
 	request := make(map[string]interface{})
@@ -445,6 +792,11 @@ func (r *clusterResource) modelToUpdateRequest(ctx context.Context, model *resou
 	// For update, we use the same structure as create but exclude computed fields
 	request := r.modelToCreateRequest(ctx, model, diags)
 
+	// enabled is not part of the create request; clusters are started with a separate PATCH
+	if !model.Enabled.IsNull() && !model.Enabled.IsUnknown() {
+		request["enabled"] = model.Enabled.ValueBool()
+	}
+
 	return request
 }
 
@@ -476,7 +828,7 @@ func (r *clusterResource) updateModelFromResponse(ctx context.Context, model *re
 	// Extended fields (computed) - enabled field from response
 	if enabled, ok := response["enabled"].(bool); ok {
 		model.Enabled = types.BoolValue(enabled)
-	} else {
+	} else if model.Enabled.IsUnknown() {
 		model.Enabled = types.BoolNull()
 	}
 
@@ -550,21 +902,22 @@ func (r *clusterResource) updateModelFromResponse(ctx context.Context, model *re
 		model.Replicas = types.Int64Value(int64(replicas))
 	}
 
-	// Handle catalog references. catalog_refs is Required, so Terraform requires the applied
-	// state to exactly match the plan order, but the Galaxy API does not preserve submission
-	// order - reorder the response to match the plan to avoid "Provider produced inconsistent
-	// result after apply". Catalogs present in the response but not in the plan are appended
-	// after the plan-ordered set rather than silently dropped, so drift is visible on the next
-	// Read/plan instead of being masked.
-	if catalogRefs, ok := response["catalogRefs"].([]interface{}); ok {
+	// Handle catalog references. catalog_refs only holds the catalogs this resource manages, so
+	// catalogs attached by other resources are filtered out rather than reported as drift, and a
+	// null catalog_refs manages none. A managed catalog missing from the response drops out of
+	// state so its removal is visible on the next plan. Terraform requires the applied state to
+	// exactly match the plan order, but the Galaxy API does not preserve submission order -
+	// reorder the response to match the plan to avoid "Provider produced inconsistent result
+	// after apply".
+	if _, ok := response["catalogRefs"].([]interface{}); ok && !model.CatalogRefs.IsNull() {
 		plannedCatalogRefs, planDiags := stringListElements(ctx, model.CatalogRefs)
 		diags.Append(planDiags...)
 
-		catalogRefStrings := make([]string, 0, len(catalogRefs))
-		for _, ref := range catalogRefs {
-			if refStr, ok := ref.(string); ok {
-				catalogRefStrings = append(catalogRefStrings, refStr)
-			}
+		catalogRefStrings := clusterCatalogRefs(response)
+		if !model.CatalogRefs.IsUnknown() {
+			catalogRefStrings = slices.DeleteFunc(catalogRefStrings, func(ref string) bool {
+				return !slices.Contains(plannedCatalogRefs, ref)
+			})
 		}
 		catalogRefStrings = reorderToMatchPlan(plannedCatalogRefs, catalogRefStrings)
 
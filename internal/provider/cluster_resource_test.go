@@ -4,14 +4,30 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/provider/resource_cluster"
 )
 
 var clusterTestSuffix = id.UniqueId()[10:24]
@@ -60,7 +76,8 @@ func TestAccResourceCluster_Basic(t *testing.T) {
 					"private_link_cluster",
 					"result_cache_enabled",
 					"warp_resiliency_enabled",
-					"trino_uri", // computed, only available when cluster is ENABLED
+					"trino_uri",    // computed, only available when cluster is ENABLED
+					"catalog_refs", // not set on import; the first apply adopts the configuration
 				},
 			},
 			// Update and Read testing
@@ -113,7 +130,8 @@ func TestAccResourceCluster_ResultCache(t *testing.T) {
 					"result_cache_enabled",
 					"result_cache_default_visibility_seconds",
 					"warp_resiliency_enabled",
-					"trino_uri", // computed, only available when cluster is ENABLED
+					"trino_uri",    // computed, only available when cluster is ENABLED
+					"catalog_refs", // not set on import; the first apply adopts the configuration
 				},
 			},
 		},
@@ -159,7 +177,8 @@ func TestAccResourceCluster_WarpSpeed(t *testing.T) {
 					"result_cache_enabled",
 					"processing_mode",
 					"warp_resiliency_enabled",
-					"trino_uri", // computed, only available when cluster is ENABLED
+					"trino_uri",    // computed, only available when cluster is ENABLED
+					"catalog_refs", // not set on import; the first apply adopts the configuration
 				},
 			},
 		},
@@ -286,6 +305,7 @@ func TestAccResourceCluster_WarpSpeedOmitted(t *testing.T) {
 					"processing_mode",
 					"warp_resiliency_enabled",
 					"trino_uri",
+					"catalog_refs",
 				},
 			},
 		},
@@ -327,7 +347,8 @@ func TestAccResourceCluster_MinimalConfig(t *testing.T) {
 					"private_link_cluster",
 					"result_cache_enabled",
 					"warp_resiliency_enabled",
-					"trino_uri", // computed, only available when cluster is ENABLED
+					"trino_uri",    // computed, only available when cluster is ENABLED
+					"catalog_refs", // not set on import; the first apply adopts the configuration
 				},
 			},
 		},
@@ -414,3 +435,357 @@ resource "galaxy_cluster" "test_cache" {
 }
 `, name)
 }
+
+// newFakeClusterAPI serves GET /public/api/v1/cluster/{id} from a sequence of responses and
+// returns a cluster resource talking to it. A nil response answers 404.
+func newFakeClusterAPI(t *testing.T, responses ...map[string]interface{}) *clusterResource {
+	t.Helper()
+	var requests int
+	c := newFakeGalaxyClient(t, func(w http.ResponseWriter, req *http.Request) {
+		response := responses[min(requests, len(responses)-1)]
+		requests++
+		if response == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	})
+	return &clusterResource{client: c, pollInterval: 10 * time.Millisecond}
+}
+
+func TestWaitForClusterDeleted(t *testing.T) {
+	r := newFakeClusterAPI(t, map[string]interface{}{"clusterId": "c-1", "clusterState": "STOPPING"}, nil)
+	if err := r.waitForClusterDeleted(context.Background(), "c-1", time.Minute); err != nil {
+		t.Fatalf("expected the wait to end once the cluster is gone, got %v", err)
+	}
+
+	r = newFakeClusterAPI(t, map[string]interface{}{"clusterId": "c-1", "clusterState": "STOPPING"})
+	err := r.waitForClusterDeleted(context.Background(), "c-1", 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "before timeout") {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+}
+
+func TestClusterNeedsRestartWait(t *testing.T) {
+	state := resource_cluster.ClusterModel{
+		MinWorkers:      types.Int64Value(1),
+		MaxWorkers:      types.Int64Value(2),
+		Replicas:        types.Int64Null(),
+		ProcessingMode:  types.StringValue("WarpSpeed"),
+		CatalogRefs:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("cat-1")}),
+		IdleStopMinutes: types.Int64Value(15),
+	}
+
+	plan := state
+	plan.IdleStopMinutes = types.Int64Value(30)
+	if clusterNeedsRestartWait(&plan, &state) {
+		t.Errorf("changing idle_stop_minutes should not wait for a restart")
+	}
+
+	plan = state
+	plan.MaxWorkers = types.Int64Value(4)
+	if !clusterNeedsRestartWait(&plan, &state) {
+		t.Errorf("a resize should wait for the cluster to return to RUNNING")
+	}
+
+	plan = state
+	plan.CatalogRefs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("cat-1"), types.StringValue("cat-2")})
+	if !clusterNeedsRestartWait(&plan, &state) {
+		t.Errorf("a catalog change should wait for the cluster to return to RUNNING")
+	}
+}
+
+func TestWaitForClusterEnabledState(t *testing.T) {
+	stopping := map[string]interface{}{"clusterId": "c-1", "clusterState": "STOPPING", "enabled": false}
+	stopped := map[string]interface{}{"clusterId": "c-1", "clusterState": "STOPPED", "enabled": false}
+	starting := map[string]interface{}{"clusterId": "c-1", "clusterState": "STARTING", "enabled": true}
+	running := map[string]interface{}{"clusterId": "c-1", "clusterState": "RUNNING", "enabled": true}
+
+	r := newFakeClusterAPI(t, stopping, stopped)
+	if _, err := r.waitForClusterStopped(context.Background(), "c-1", time.Minute); err != nil {
+		t.Fatalf("expected a disabled cluster to be waited on until STOPPED, got %v", err)
+	}
+
+	r = newFakeClusterAPI(t, stopped)
+	_, err := r.waitForClusterRunning(context.Background(), "c-1", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "terminal state STOPPED") {
+		t.Fatalf("expected STOPPED to fail a wait for RUNNING, got %v", err)
+	}
+
+	r = newFakeClusterAPI(t, stopped, starting, running)
+	if _, err := r.waitForClusterStarted(context.Background(), "c-1", time.Minute); err != nil {
+		t.Fatalf("expected a re-enabled cluster to be waited on until RUNNING, got %v", err)
+	}
+
+	r = newFakeClusterAPI(t, stopped, starting, stopped)
+	_, err = r.waitForClusterStarted(context.Background(), "c-1", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "terminal state STOPPED") {
+		t.Fatalf("expected a cluster that stops again while starting to fail, got %v", err)
+	}
+}
+
+func TestClusterEnabledChanged(t *testing.T) {
+	r := &clusterResource{}
+	state := resource_cluster.ClusterModel{Enabled: types.BoolValue(true), CatalogRefs: types.ListNull(types.StringType)}
+
+	plan := state
+	plan.Enabled = types.BoolValue(false)
+	if !clusterEnabledChanged(&plan, &state) {
+		t.Errorf("setting enabled = false should stop the cluster")
+	}
+	if request := r.modelToUpdateRequest(context.Background(), &plan, nil); request["enabled"] != false {
+		t.Errorf("expected the update request to disable the cluster, got %v", request["enabled"])
+	}
+
+	plan.Enabled = types.BoolNull()
+	if clusterEnabledChanged(&plan, &state) {
+		t.Errorf("omitting enabled should leave the cluster as it is")
+	}
+	if _, ok := r.modelToUpdateRequest(context.Background(), &plan, nil)["enabled"]; ok {
+		t.Errorf("omitting enabled should not send it")
+	}
+}
+
+func TestMergeCatalogRefs(t *testing.T) {
+	// cat-3 was attached by galaxy_cluster_catalog_attachment and must survive the update
+	got := mergeCatalogRefs([]string{"cat-1", "cat-2", "cat-3"}, []string{"cat-1", "cat-2"}, []string{"cat-2", "cat-4"})
+	want := []string{"cat-2", "cat-4", "cat-3"}
+	if !slices.Equal(got, want) {
+		t.Errorf("mergeCatalogRefs() = %v, want %v", got, want)
+	}
+
+	if got := mergeCatalogRefs([]string{"cat-1"}, []string{"cat-1"}, nil); got == nil || len(got) != 0 {
+		t.Errorf("removing the last managed catalog should send an empty list, got %#v", got)
+	}
+}
+
+func TestClusterImportLeavesAttachedCatalogs(t *testing.T) {
+	ctx := context.Background()
+	r := &clusterResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	resp := &fwresource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "c-1"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var imported types.List
+	resp.State.GetAttribute(ctx, path.Root("catalog_refs"), &imported)
+	if !imported.IsNull() {
+		t.Fatalf("expected an imported cluster to manage no catalogs, got %v", imported)
+	}
+
+	// cat-2 is owned by a galaxy_cluster_catalog_attachment. Refreshing the imported cluster
+	// must not report it, and the first apply of a config listing only cat-1 must keep it.
+	model := resource_cluster.ClusterModel{CatalogRefs: imported}
+	var diags diag.Diagnostics
+	r.updateModelFromResponse(ctx, &model, map[string]interface{}{"catalogRefs": []interface{}{"cat-1", "cat-2"}}, &diags)
+	if !model.CatalogRefs.IsNull() || diags.HasError() {
+		t.Fatalf("expected the refresh to leave catalog_refs null, got %v (%v)", model.CatalogRefs, diags)
+	}
+
+	previous, _ := stringListElements(ctx, model.CatalogRefs)
+	if got := mergeCatalogRefs([]string{"cat-1", "cat-2"}, previous, []string{"cat-1"}); !slices.Equal(got, []string{"cat-1", "cat-2"}) {
+		t.Errorf("expected the attachment's catalog to survive the first apply, got %v", got)
+	}
+}
+
+func TestUpdateModelFromResponseIgnoresUnmanagedCatalogs(t *testing.T) {
+	r := &clusterResource{}
+	response := map[string]interface{}{"catalogRefs": []interface{}{"cat-3", "cat-2", "cat-1"}}
+
+	model := resource_cluster.ClusterModel{
+		CatalogRefs: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("cat-1"), types.StringValue("cat-2")}),
+	}
+	var diags diag.Diagnostics
+	r.updateModelFromResponse(context.Background(), &model, response, &diags)
+	refs, _ := stringListElements(context.Background(), model.CatalogRefs)
+	if !slices.Equal(refs, []string{"cat-1", "cat-2"}) {
+		t.Errorf("expected only the managed catalogs in plan order, got %v", refs)
+	}
+
+	model = resource_cluster.ClusterModel{CatalogRefs: types.ListNull(types.StringType)}
+	r.updateModelFromResponse(context.Background(), &model, response, &diags)
+	if !model.CatalogRefs.IsNull() {
+		t.Errorf("a cluster without catalog_refs should not manage any catalogs, got %v", model.CatalogRefs)
+	}
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestValidateClusterConfig(t *testing.T) {
+	valid := resource_cluster.ClusterModel{
+		CloudRegionId:                       types.StringValue("aws-us-east1"),
+		MinWorkers:                          types.Int64Value(1),
+		MaxWorkers:                          types.Int64Value(2),
+		Replicas:                            types.Int64Null(),
+		ProcessingMode:                      types.StringNull(),
+		ResultCacheEnabled:                  types.BoolValue(false),
+		ResultCacheDefaultVisibilitySeconds: types.Int64Null(),
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*resource_cluster.ClusterModel)
+		wantError   string
+		wantWarning string
+	}{
+		{name: "valid", modify: func(*resource_cluster.ClusterModel) {}},
+		{
+			name:      "min above max",
+			modify:    func(m *resource_cluster.ClusterModel) { m.MinWorkers = types.Int64Value(3) },
+			wantError: "Invalid Worker Range",
+		},
+		{
+			name: "unknown max is not checked",
+			modify: func(m *resource_cluster.ClusterModel) {
+				m.MinWorkers = types.Int64Value(3)
+				m.MaxWorkers = types.Int64Unknown()
+			},
+		},
+		{
+			name: "replicas without batch",
+			modify: func(m *resource_cluster.ClusterModel) {
+				m.Replicas = types.Int64Value(2)
+				m.ProcessingMode = types.StringValue("WarpSpeed")
+			},
+			wantError: "Replicas Require Batch Processing Mode",
+		},
+		{
+			name:   "replicas with processing_mode omitted",
+			modify: func(m *resource_cluster.ClusterModel) { m.Replicas = types.Int64Value(2) },
+		},
+		{
+			name: "replicas with batch",
+			modify: func(m *resource_cluster.ClusterModel) {
+				m.Replicas = types.Int64Value(2)
+				m.ProcessingMode = types.StringValue("Batch")
+			},
+		},
+		{
+			name:      "visibility without cache",
+			modify:    func(m *resource_cluster.ClusterModel) { m.ResultCacheDefaultVisibilitySeconds = types.Int64Value(3600) },
+			wantError: "Result Cache Disabled",
+		},
+		{
+			name:        "unknown region",
+			modify:      func(m *resource_cluster.ClusterModel) { m.CloudRegionId = types.StringValue("aws-moon-1") },
+			wantWarning: "Unknown Cloud Region",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+
+			var diags diag.Diagnostics
+			validateClusterConfig(&config, &diags)
+			if tt.wantWarning != "" && (diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != tt.wantWarning) {
+				t.Fatalf("expected a single %q warning, got %v", tt.wantWarning, diags)
+			}
+			if tt.wantError == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != tt.wantError {
+				t.Fatalf("expected a single %q error, got %v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestClusterModifyPlanChecksResolvedValues(t *testing.T) {
+	r := &clusterResource{}
+	s := resourceSchema(t, r)
+	base := map[string]interface{}{
+		"name":            "c",
+		"cloud_region_id": "aws-us-east1",
+		"min_workers":     int64(3),
+		"max_workers":     int64(4),
+	}
+	with := func(changes map[string]interface{}) map[string]interface{} {
+		values := maps.Clone(base)
+		maps.Copy(values, changes)
+		return values
+	}
+	unknown := tftypes.UnknownValue
+
+	tests := []struct {
+		name        string
+		config      map[string]interface{}
+		plan        map[string]interface{}
+		wantError   string
+		wantWarning string
+	}{
+		{name: "valid", config: base, plan: base},
+		{
+			name:      "worker range known only in the plan",
+			config:    with(map[string]interface{}{"max_workers": unknown}),
+			plan:      with(map[string]interface{}{"max_workers": int64(2)}),
+			wantError: "Invalid Worker Range",
+		},
+		{
+			name:        "region known only in the plan",
+			config:      with(map[string]interface{}{"cloud_region_id": unknown}),
+			plan:        with(map[string]interface{}{"cloud_region_id": "aws-moon-1"}),
+			wantWarning: "Unknown Cloud Region",
+		},
+		{
+			name:      "omitted processing_mode keeps a non-Batch prior value",
+			config:    with(map[string]interface{}{"replicas": int64(2)}),
+			plan:      with(map[string]interface{}{"replicas": int64(2), "processing_mode": "WarpSpeed"}),
+			wantError: "Replicas Require Batch Processing Mode",
+		},
+		{
+			// ValidateConfig already warned about the configured region
+			name:   "diagnostics from the configuration are not repeated",
+			config: with(map[string]interface{}{"cloud_region_id": "aws-moon-1"}),
+			plan:   with(map[string]interface{}{"cloud_region_id": "aws-moon-1"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: schemaObject(t, s, tt.config)},
+				Plan:   tfsdk.Plan{Schema: s, Raw: schemaObject(t, s, tt.plan)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			diags := resp.Diagnostics
+			switch {
+			case tt.wantError != "":
+				if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != tt.wantError {
+					t.Fatalf("expected a single %q error, got %v", tt.wantError, diags)
+				}
+			case tt.wantWarning != "":
+				if diags.HasError() || diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != tt.wantWarning {
+					t.Fatalf("expected a single %q warning, got %v", tt.wantWarning, diags)
+				}
+			case len(diags) != 0:
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+
+	// Nothing is checked on destroy
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)},
+		Plan:   tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)},
+	}
+	resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, resp)
+	if len(resp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics on destroy: %v", resp.Diagnostics)
+	}
+}
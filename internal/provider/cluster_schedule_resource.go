@@ -0,0 +1,443 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*clusterScheduleResource)(nil)
+var _ resource.ResourceWithConfigure = (*clusterScheduleResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*clusterScheduleResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*clusterScheduleResource)(nil)
+
+func NewClusterScheduleResource() resource.Resource {
+	return &clusterScheduleResource{}
+}
+
+type clusterScheduleResource struct {
+	client *client.GalaxyClient
+
+	// now overrides time.Now in tests
+	now func() time.Time
+}
+
+type clusterScheduleModel struct {
+	ClusterId      types.String `tfsdk:"cluster_id"`
+	Timezone       types.String `tfsdk:"timezone"`
+	Windows        types.List   `tfsdk:"windows"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	NextTransition types.String `tfsdk:"next_transition"`
+}
+
+type clusterScheduleWindowModel struct {
+	Days  types.List   `tfsdk:"days"`
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+// scheduleWindow is a parsed window: the cluster is enabled on each of days from start until
+// end, both in minutes after midnight. An end at or before start runs into the next day.
+type scheduleWindow struct {
+	days       []time.Weekday
+	start, end int
+}
+
+// scheduleWeekdays maps the accepted day names to weekdays.
+var scheduleWeekdays = map[string]time.Weekday{
+	"MON": time.Monday, "TUE": time.Tuesday, "WED": time.Wednesday, "THU": time.Thursday,
+	"FRI": time.Friday, "SAT": time.Saturday, "SUN": time.Sunday,
+}
+
+var scheduleTimePattern = regexp.MustCompile(`^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$`)
+
+func (r *clusterScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_schedule"
+}
+
+func (r *clusterScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables a cluster during weekly time windows and disables it outside them. Terraform only changes the cluster when it runs, so apply the configuration on a schedule (for example from CI) at least as often as the windows change. Leave enabled unset on the galaxy_cluster resource so the two do not fight over it. Deleting the schedule leaves the cluster in its current state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the cluster to enable and disable.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("UTC"),
+				Description: "IANA time zone the windows are expressed in, for example America/New_York. Defaults to UTC.",
+				Validators: []validator.String{
+					timezoneValidator{},
+				},
+			},
+			"windows": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Time windows during which the cluster is enabled. Overlapping windows are merged.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"days": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Days on which the window starts: MON, TUE, WED, THU, FRI, SAT or SUN.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf("MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN")),
+							},
+						},
+						"start": schema.StringAttribute{
+							Required:    true,
+							Description: "Time of day the window starts, as HH:MM.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(scheduleTimePattern, "must be a time of day as HH:MM"),
+							},
+						},
+						"end": schema.StringAttribute{
+							Required:    true,
+							Description: "Time of day the window ends, as HH:MM. 24:00 ends at midnight. An end before the start runs into the next day.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(scheduleTimePattern, "must be a time of day as HH:MM"),
+							},
+						},
+					},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the cluster is enabled. Refreshed from the cluster and planned from the schedule, so a plan shows a change when the two differ.",
+			},
+			"next_transition": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time (RFC 3339) at which the schedule next enables or disables the cluster, as of the last plan. Null when the windows cover the whole week.",
+			},
+		},
+	}
+}
+
+func (r *clusterScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *clusterScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var list types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("windows"), &list)...)
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+
+	var windows []clusterScheduleWindowModel
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &windows, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, w := range windows {
+		if !w.Start.IsNull() && !w.Start.IsUnknown() && w.Start.Equal(w.End) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("windows").AtListIndex(i).AtName("end"),
+				"Empty Schedule Window",
+				"end must differ from start. Use 00:00 to 24:00 for a window spanning the whole day.",
+			)
+		}
+	}
+}
+
+func (r *clusterScheduleResource) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// ModifyPlan evaluates the schedule at plan time. When the cluster's current state differs
+// from the one the schedule wants, the plan shows enabled changing and the apply switches it.
+func (r *clusterScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to evaluate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan clusterScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windows, loc, known := plan.schedule(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+
+	enabled, next, ok := clusterScheduleState(windows, loc, r.clock())
+	plan.Enabled = types.BoolValue(enabled)
+	plan.NextTransition = types.StringNull()
+	if ok {
+		plan.NextTransition = types.StringValue(next.Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *clusterScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clusterScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state clusterScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := state.ClusterId.ValueString()
+
+	tflog.Debug(ctx, "Reading cluster_schedule", map[string]interface{}{
+		"cluster_id": clusterID,
+	})
+
+	clusterResp, err := r.client.GetCluster(ctx, clusterID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Cluster not found, removing cluster_schedule from state", map[string]interface{}{
+				"cluster_id": clusterID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading cluster",
+			"Could not read cluster "+clusterID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Record the cluster's actual state so ModifyPlan shows a change when it differs from
+	// the schedule, whether because a window started or ended or because someone switched
+	// the cluster outside Terraform.
+	if enabled, ok := clusterResp["enabled"].(bool); ok {
+		state.Enabled = types.BoolValue(enabled)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *clusterScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan clusterScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The schedule only exists in Terraform state; the cluster keeps its current state.
+	tflog.Debug(ctx, "Deleted cluster_schedule")
+}
+
+// apply enables or disables the cluster as planned. The planned value is used rather than
+// re-evaluating the schedule, which could cross a window boundary between plan and apply and
+// produce an inconsistent result.
+func (r *clusterScheduleResource) apply(ctx context.Context, plan *clusterScheduleModel, diags *diag.Diagnostics) {
+	if plan.Enabled.IsUnknown() {
+		windows, loc, _ := plan.schedule(ctx, diags)
+		if diags.HasError() {
+			return
+		}
+		enabled, next, ok := clusterScheduleState(windows, loc, r.clock())
+		plan.Enabled = types.BoolValue(enabled)
+		plan.NextTransition = types.StringNull()
+		if ok {
+			plan.NextTransition = types.StringValue(next.Format(time.RFC3339))
+		}
+	}
+
+	clusterID := plan.ClusterId.ValueString()
+	enabled := plan.Enabled.ValueBool()
+
+	tflog.Info(ctx, "Applying cluster schedule", map[string]interface{}{
+		"cluster_id":      clusterID,
+		"enabled":         enabled,
+		"next_transition": plan.NextTransition.ValueString(),
+	})
+
+	_, err := r.client.UpdateCluster(ctx, clusterID, map[string]interface{}{"enabled": enabled})
+	if err != nil {
+		diags.AddError(
+			"Error applying cluster schedule",
+			fmt.Sprintf("Could not set enabled = %t on cluster %s: %s", enabled, clusterID, err),
+		)
+	}
+}
+
+// schedule parses the windows and timezone. known is false when either is not yet known.
+func (m *clusterScheduleModel) schedule(ctx context.Context, diags *diag.Diagnostics) ([]scheduleWindow, *time.Location, bool) {
+	if m.Windows.IsUnknown() || m.Timezone.IsUnknown() {
+		return nil, nil, false
+	}
+
+	loc, err := time.LoadLocation(m.Timezone.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("timezone"), "Invalid Timezone", err.Error())
+		return nil, nil, false
+	}
+
+	var windowModels []clusterScheduleWindowModel
+	diags.Append(m.Windows.ElementsAs(ctx, &windowModels, false)...)
+	if diags.HasError() {
+		return nil, nil, false
+	}
+
+	windows := make([]scheduleWindow, 0, len(windowModels))
+	for _, wm := range windowModels {
+		if wm.Days.IsUnknown() || wm.Start.IsUnknown() || wm.End.IsUnknown() {
+			return nil, nil, false
+		}
+
+		var dayNames []string
+		diags.Append(wm.Days.ElementsAs(ctx, &dayNames, false)...)
+		if diags.HasError() {
+			return nil, nil, false
+		}
+
+		w := scheduleWindow{start: parseScheduleTime(wm.Start.ValueString()), end: parseScheduleTime(wm.End.ValueString())}
+		for _, name := range dayNames {
+			w.days = append(w.days, scheduleWeekdays[name])
+		}
+		windows = append(windows, w)
+	}
+	return windows, loc, true
+}
+
+// parseScheduleTime converts an HH:MM value that passed scheduleTimePattern to minutes after midnight.
+func parseScheduleTime(value string) int {
+	hours, minutes, _ := strings.Cut(value, ":")
+	h, _ := strconv.Atoi(hours)
+	m, _ := strconv.Atoi(minutes)
+	return h*60 + m
+}
+
+// clusterScheduleState reports whether the windows want the cluster enabled at now and when
+// that next changes. ok is false when it never changes, i.e. the windows are empty or cover
+// the whole week.
+func clusterScheduleState(windows []scheduleWindow, loc *time.Location, now time.Time) (enabled bool, next time.Time, ok bool) {
+	type interval struct{ start, end time.Time }
+
+	// The schedule repeats weekly, so occurrences from the day before now (which may still be
+	// running past midnight) through a week after cover every possible next transition. Times
+	// are built with time.Date so windows follow the local clock across DST changes.
+	local := now.In(loc)
+	var intervals []interval
+	for offset := -1; offset <= 8; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, loc)
+		for _, w := range windows {
+			for _, d := range w.days {
+				if d != day.Weekday() {
+					continue
+				}
+				endDay := day.Day()
+				if w.end <= w.start {
+					endDay++
+				}
+				intervals = append(intervals, interval{
+					start: time.Date(day.Year(), day.Month(), day.Day(), 0, w.start, 0, 0, loc),
+					end:   time.Date(day.Year(), day.Month(), endDay, 0, w.end, 0, 0, loc),
+				})
+			}
+		}
+	}
+	if len(intervals) == 0 {
+		return false, time.Time{}, false
+	}
+
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].start.Before(intervals[j].start) })
+	merged := intervals[:1]
+	for _, iv := range intervals[1:] {
+		last := &merged[len(merged)-1]
+		if iv.start.After(last.end) {
+			merged = append(merged, iv)
+		} else if iv.end.After(last.end) {
+			last.end = iv.end
+		}
+	}
+
+	horizon := now.AddDate(0, 0, 7)
+	for _, iv := range merged {
+		if now.Before(iv.start) {
+			return false, iv.start, true
+		}
+		if now.Before(iv.end) {
+			// A window that extends past the horizon is the whole week merged into one.
+			if iv.end.After(horizon) {
+				return true, time.Time{}, false
+			}
+			return true, iv.end, true
+		}
+	}
+	return false, time.Time{}, false
+}
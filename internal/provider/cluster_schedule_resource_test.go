@@ -0,0 +1,136 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestClusterScheduleState(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	businessHours := []scheduleWindow{{days: weekdays, start: 8 * 60, end: 18 * 60}}
+
+	tests := []struct {
+		name        string
+		windows     []scheduleWindow
+		now         time.Time
+		wantEnabled bool
+		wantNext    string
+	}{
+		{
+			name:        "inside a window",
+			windows:     businessHours,
+			now:         time.Date(2025, 3, 4, 10, 0, 0, 0, newYork), // Tuesday
+			wantEnabled: true,
+			wantNext:    "2025-03-04T18:00:00-05:00",
+		},
+		{
+			name:     "overnight between windows",
+			windows:  businessHours,
+			now:      time.Date(2025, 3, 4, 20, 0, 0, 0, newYork),
+			wantNext: "2025-03-05T08:00:00-05:00",
+		},
+		{
+			name:     "weekend",
+			windows:  businessHours,
+			now:      time.Date(2025, 3, 8, 12, 0, 0, 0, newYork), // Saturday
+			wantNext: "2025-03-10T08:00:00-04:00",                 // after the DST change
+		},
+		{
+			name:        "window running past midnight",
+			windows:     []scheduleWindow{{days: []time.Weekday{time.Friday}, start: 22 * 60, end: 2 * 60}},
+			now:         time.Date(2025, 3, 8, 1, 0, 0, 0, newYork), // early Saturday
+			wantEnabled: true,
+			wantNext:    "2025-03-08T02:00:00-05:00",
+		},
+		{
+			name: "adjacent windows are merged",
+			windows: []scheduleWindow{
+				{days: []time.Weekday{time.Monday}, start: 0, end: 24 * 60},
+				{days: []time.Weekday{time.Tuesday}, start: 0, end: 12 * 60},
+			},
+			now:         time.Date(2025, 3, 3, 23, 0, 0, 0, newYork),
+			wantEnabled: true,
+			wantNext:    "2025-03-04T12:00:00-05:00",
+		},
+		{
+			name:        "whole week",
+			windows:     []scheduleWindow{{days: append(weekdays, time.Saturday, time.Sunday), start: 0, end: 24 * 60}},
+			now:         time.Date(2025, 3, 4, 10, 0, 0, 0, newYork),
+			wantEnabled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enabled, next, ok := clusterScheduleState(tt.windows, newYork, tt.now)
+			if enabled != tt.wantEnabled {
+				t.Errorf("enabled = %t, want %t", enabled, tt.wantEnabled)
+			}
+			got := ""
+			if ok {
+				got = next.Format(time.RFC3339)
+			}
+			if got != tt.wantNext {
+				t.Errorf("next transition = %q, want %q", got, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestClusterScheduleApplyUsesClock(t *testing.T) {
+	var patched map[string]interface{}
+	c := newFakeGalaxyClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPatch {
+			_ = json.NewDecoder(req.Body).Decode(&patched)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"clusterId": "c-1"}`))
+	})
+
+	r := &clusterScheduleResource{
+		client: c,
+		now:    func() time.Time { return time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC) }, // Saturday
+	}
+
+	window := types.ObjectValueMust(
+		map[string]attr.Type{"days": types.ListType{ElemType: types.StringType}, "start": types.StringType, "end": types.StringType},
+		map[string]attr.Value{
+			"days":  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("MON")}),
+			"start": types.StringValue("09:00"),
+			"end":   types.StringValue("17:00"),
+		},
+	)
+	plan := clusterScheduleModel{
+		ClusterId:      types.StringValue("c-1"),
+		Timezone:       types.StringValue("UTC"),
+		Windows:        types.ListValueMust(window.Type(context.Background()), []attr.Value{window}),
+		Enabled:        types.BoolUnknown(),
+		NextTransition: types.StringUnknown(),
+	}
+
+	var diags diag.Diagnostics
+	r.apply(context.Background(), &plan, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if patched["enabled"] != false {
+		t.Errorf("expected the cluster to be disabled outside the window, got %v", patched)
+	}
+	if plan.NextTransition.ValueString() != "2025-03-10T09:00:00Z" {
+		t.Errorf("unexpected next_transition %s", plan.NextTransition)
+	}
+}
@@ -99,10 +99,7 @@ func (r *column_maskResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Debug(ctx, "Creating column_mask")
 	response, err := r.client.CreateColumnMask(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating column_mask",
-			"Could not create column_mask: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating column_mask", "Could not create column_mask", err)
 		return
 	}
 
@@ -171,10 +168,7 @@ func (r *column_maskResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Debug(ctx, "Updating column_mask", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateColumnMask(ctx, id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating column_mask",
-			"Could not update column_mask "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating column_mask", "Could not update column_mask "+id, err)
 		return
 	}
 
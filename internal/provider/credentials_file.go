@@ -0,0 +1,186 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultProfile is read from the credentials file when no profile is selected and some
+// settings are missing from the configuration and environment.
+const defaultProfile = "default"
+
+// galaxyProfile holds the settings of a named profile in the credentials file.
+type galaxyProfile struct {
+	ClientID     string
+	ClientSecret string
+	Domain       string
+
+	// UnknownKeys lists settings of the profile that the provider does not use, such as those
+	// of other tools sharing the file.
+	UnknownKeys []string
+}
+
+// credentialsFilePath returns the location of the credentials file: GALAXY_CREDENTIALS_FILE
+// when set, otherwise ~/.galaxy/credentials.
+func credentialsFilePath() (string, error) {
+	if p := os.Getenv("GALAXY_CREDENTIALS_FILE"); p != "" {
+		return p, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not determine home directory: %w", err)
+	}
+	return filepath.Join(home, ".galaxy", "credentials"), nil
+}
+
+// readCredentialsFile parses a credentials file in either INI form:
+//
+//	[prod]
+//	client_id = ...
+//
+// or YAML form:
+//
+//	prod:
+//	  client_id: ...
+//
+// This is not a full INI or YAML parser. Each line holds a profile header or one setting.
+// Lines starting with # or ; are comments, as is anything after a # or ; that follows
+// whitespace. Values may be wrapped in single or double quotes, without escape sequences,
+// to keep such characters or surrounding spaces. Unknown settings are recorded in the
+// profile's UnknownKeys rather than rejected, so the file can be shared with other tools.
+func readCredentialsFile(data []byte) (map[string]galaxyProfile, error) {
+	profiles := make(map[string]galaxyProfile)
+	current := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		raw := scanner.Text()
+		line := strings.TrimSpace(raw)
+		if line == "" || line == "---" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			end := strings.Index(line, "]")
+			if end < 0 || stripCredentialsComment(line[end+1:]) != "" {
+				return nil, fmt.Errorf("line %d: expected a [profile] header", lineNumber)
+			}
+			current = strings.TrimSpace(line[1:end])
+			profiles[current] = profiles[current]
+			continue
+		}
+
+		key, separator, value, err := splitCredentialsEntry(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		// An unindented "name:" without a value starts a YAML profile
+		indented := raw[0] == ' ' || raw[0] == '\t'
+		if !indented && separator == ':' && value == "" {
+			current = unquote(key)
+			profiles[current] = profiles[current]
+			continue
+		}
+		if current == "" {
+			return nil, fmt.Errorf("line %d: %s is not inside a profile", lineNumber, key)
+		}
+
+		profile := profiles[current]
+		switch key {
+		case "client_id":
+			profile.ClientID = value
+		case "client_secret":
+			profile.ClientSecret = value
+		case "domain":
+			profile.Domain = value
+		default:
+			profile.UnknownKeys = append(profile.UnknownKeys, key)
+		}
+		profiles[current] = profile
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// splitCredentialsEntry splits an INI "key = value" or YAML "key: value" line at the first
+// = or :, and returns the separator and the value without quotes or trailing comment.
+func splitCredentialsEntry(line string) (key string, separator byte, value string, err error) {
+	i := strings.IndexAny(line, "=:")
+	if i <= 0 {
+		return "", 0, "", fmt.Errorf("expected a key and value")
+	}
+	key = strings.TrimSpace(line[:i])
+	rest := strings.TrimSpace(line[i+1:])
+
+	if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+		end := strings.IndexByte(rest[1:], rest[0])
+		if end < 0 {
+			return "", 0, "", fmt.Errorf("unterminated quoted value for %s", key)
+		}
+		if stripCredentialsComment(rest[end+2:]) != "" {
+			return "", 0, "", fmt.Errorf("unexpected text after the quoted value for %s", key)
+		}
+		return key, line[i], rest[1 : end+1], nil
+	}
+	return key, line[i], stripCredentialsComment(rest), nil
+}
+
+// stripCredentialsComment removes a comment, started by a # or ; at the beginning or after
+// whitespace, from an unquoted value.
+func stripCredentialsComment(s string) string {
+	for i := 0; i < len(s); i++ {
+		if (s[i] == '#' || s[i] == ';') && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t') {
+			s = s[:i]
+			break
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// loadProfile reads the named profile from the credentials file. found is false when the
+// file or the profile does not exist.
+func loadProfile(name string) (profile galaxyProfile, file string, found bool, err error) {
+	file, err = credentialsFilePath()
+	if err != nil {
+		return galaxyProfile{}, "", false, err
+	}
+
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return galaxyProfile{}, file, false, nil
+	}
+	if err != nil {
+		return galaxyProfile{}, file, false, err
+	}
+
+	profiles, err := readCredentialsFile(data)
+	if err != nil {
+		return galaxyProfile{}, file, false, fmt.Errorf("could not parse %s: %w", file, err)
+	}
+	profile, found = profiles[name]
+	return profile, file, found, nil
+}
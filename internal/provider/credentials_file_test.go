@@ -0,0 +1,182 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReadCredentialsFile(t *testing.T) {
+	want := map[string]galaxyProfile{
+		"default": {ClientID: "default-id", ClientSecret: "default-secret", Domain: "https://default.galaxy.starburst.io"},
+		"prod":    {ClientID: "prod-id", ClientSecret: "p=ss:word", Domain: "https://prod.galaxy.starburst.io"},
+	}
+
+	cases := map[string]string{
+		"ini": `
+; Galaxy accounts
+[default]
+client_id = default-id
+client_secret = default-secret
+domain = https://default.galaxy.starburst.io
+
+[prod]
+client_id     = prod-id
+client_secret = "p=ss:word"
+domain        = https://prod.galaxy.starburst.io
+`,
+		"yaml": `
+---
+# Galaxy accounts
+default:
+  client_id: default-id
+  client_secret: default-secret
+  domain: https://default.galaxy.starburst.io
+prod:
+  client_id: prod-id
+  client_secret: 'p=ss:word'
+  domain: "https://prod.galaxy.starburst.io"
+`,
+	}
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			profiles, err := readCredentialsFile([]byte(content))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(profiles) != len(want) {
+				t.Fatalf("expected %d profiles, got %v", len(want), profiles)
+			}
+			for profileName, profile := range want {
+				if !equalProfiles(profiles[profileName], profile) {
+					t.Errorf("profile %s: expected %+v, got %+v", profileName, profile, profiles[profileName])
+				}
+			}
+		})
+	}
+
+	for _, invalid := range []string{
+		"client_id = orphan\n",
+		"[prod]\nclient_id\n",
+		"[prod\nclient_id = prod-id\n",
+		"[prod]\nclient_secret = \"unterminated\n",
+		"[prod]\nclient_secret = 'secret' trailing\n",
+	} {
+		if _, err := readCredentialsFile([]byte(invalid)); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestReadCredentialsFileComments(t *testing.T) {
+	content := `
+[prod] # production account
+client_id = prod-id # from the admin console
+client_secret = "abc # not a comment" ; rotated monthly
+domain = https://prod.galaxy.starburst.io;main
+staging:  # YAML profile
+  client_id: 'staging id'  # quoted to keep the space
+  client_secret: s3cr#t
+`
+	profiles, err := readCredentialsFile([]byte(content))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]galaxyProfile{
+		"prod":    {ClientID: "prod-id", ClientSecret: "abc # not a comment", Domain: "https://prod.galaxy.starburst.io;main"},
+		"staging": {ClientID: "staging id", ClientSecret: "s3cr#t"},
+	}
+	for profileName, profile := range want {
+		if !equalProfiles(profiles[profileName], profile) {
+			t.Errorf("profile %s: expected %+v, got %+v", profileName, profile, profiles[profileName])
+		}
+	}
+}
+
+func TestReadCredentialsFileUnknownKeys(t *testing.T) {
+	content := "[prod]\nclient_id = prod-id\nregion = us-east-1\noutput: json\n"
+	profiles, err := readCredentialsFile([]byte(content))
+	if err != nil {
+		t.Fatalf("expected unknown settings to be accepted, got %v", err)
+	}
+	if got := profiles["prod"]; got.ClientID != "prod-id" || !slices.Equal(got.UnknownKeys, []string{"region", "output"}) {
+		t.Errorf("expected prod-id with unknown region and output, got %+v", got)
+	}
+
+	file := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(file, []byte(content+"client_secret = prod-secret\ndomain = https://prod.galaxy.starburst.io\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GALAXY_CREDENTIALS_FILE", file)
+
+	var clientID, clientSecret, domain string
+	var diags diag.Diagnostics
+	applyProfile("prod", galaxyProviderModel{}, &clientID, &clientSecret, &domain, &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Unknown Credentials File Settings" {
+		t.Fatalf("expected a single unknown settings warning, got %v", diags)
+	}
+	if clientID != "prod-id" || clientSecret != "prod-secret" {
+		t.Errorf("unexpected settings: %s, %s", clientID, clientSecret)
+	}
+}
+
+func equalProfiles(a, b galaxyProfile) bool {
+	return a.ClientID == b.ClientID && a.ClientSecret == b.ClientSecret && a.Domain == b.Domain && slices.Equal(a.UnknownKeys, b.UnknownKeys)
+}
+
+func TestApplyProfile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials")
+	content := "[default]\nclient_id = default-id\nclient_secret = default-secret\ndomain = https://default.galaxy.starburst.io\n\n" +
+		"[prod]\nclient_id = prod-id\nclient_secret = prod-secret\ndomain = https://prod.galaxy.starburst.io\n"
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GALAXY_CREDENTIALS_FILE", file)
+
+	t.Run("selected profile overrides environment", func(t *testing.T) {
+		config := galaxyProviderModel{ClientID: types.StringNull(), ClientSecret: types.StringNull(), Domain: types.StringValue("https://explicit.galaxy.starburst.io")}
+		clientID, clientSecret, domain := "env-id", "", "https://explicit.galaxy.starburst.io"
+
+		var diags diag.Diagnostics
+		applyProfile("prod", config, &clientID, &clientSecret, &domain, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if clientID != "prod-id" || clientSecret != "prod-secret" || domain != "https://explicit.galaxy.starburst.io" {
+			t.Errorf("unexpected settings: %s, %s, %s", clientID, clientSecret, domain)
+		}
+		if diags.WarningsCount() != 1 {
+			t.Errorf("expected a warning about GALAXY_CLIENT_ID, got %v", diags)
+		}
+	})
+
+	t.Run("default profile fills unset settings", func(t *testing.T) {
+		clientID, clientSecret, domain := "env-id", "env-secret", ""
+
+		var diags diag.Diagnostics
+		applyProfile("", galaxyProviderModel{}, &clientID, &clientSecret, &domain, &diags)
+		if diags.HasError() || diags.WarningsCount() != 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if clientID != "env-id" || clientSecret != "env-secret" || domain != "https://default.galaxy.starburst.io" {
+			t.Errorf("unexpected settings: %s, %s, %s", clientID, clientSecret, domain)
+		}
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		var clientID, clientSecret, domain string
+		var diags diag.Diagnostics
+		applyProfile("staging", galaxyProviderModel{}, &clientID, &clientSecret, &domain, &diags)
+		if diags.ErrorsCount() != 1 {
+			t.Errorf("expected a profile not found error, got %v", diags)
+		}
+	})
+}
@@ -76,10 +76,7 @@ func (r *cross_account_iam_roleResource) Create(ctx context.Context, req resourc
 	tflog.Debug(ctx, "Creating cross_account_iam_role")
 	response, err := r.client.CreateCrossAccountIamRole(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating cross_account_iam_role",
-			"Could not create cross_account_iam_role: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating cross_account_iam_role", "Could not create cross_account_iam_role", err)
 		return
 	}
 
@@ -171,10 +168,7 @@ func (r *cross_account_iam_roleResource) Update(ctx context.Context, req resourc
 	tflog.Debug(ctx, "Updating cross_account_iam_role", map[string]interface{}{"alias_name": aliasName})
 	response, err := r.client.UpdateCrossAccountIamRole(ctx, aliasName, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating cross_account_iam_role",
-			"Could not update cross_account_iam_role "+aliasName+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating cross_account_iam_role", "Could not update cross_account_iam_role "+aliasName, err)
 		return
 	}
 
@@ -120,10 +120,7 @@ func (r *data_productResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Debug(ctx, "Creating data_product")
 	response, err := r.client.CreateDataProduct(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating data_product",
-			"Could not create data_product: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating data_product", "Could not create data_product", err)
 		return
 	}
 
@@ -192,10 +189,7 @@ func (r *data_productResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Debug(ctx, "Updating data_product", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateDataProduct(ctx, id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating data_product",
-			"Could not update data_product "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating data_product", "Could not update data_product "+id, err)
 		return
 	}
 
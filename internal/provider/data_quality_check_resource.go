@@ -95,10 +95,7 @@ func (r *dataQualityCheckResource) Create(ctx context.Context, req resource.Crea
 	tflog.Debug(ctx, "Creating data quality check")
 	response, err := r.client.CreateDataQualityCheck(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating data quality check",
-			"Could not create data quality check: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating data quality check", "Could not create data quality check", err)
 		return
 	}
 
@@ -169,10 +166,7 @@ func (r *dataQualityCheckResource) Update(ctx context.Context, req resource.Upda
 	tflog.Debug(ctx, "Updating data quality check", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateDataQualityCheck(ctx, id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating data quality check",
-			"Could not update data quality check "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating data quality check", "Could not update data quality check "+id, err)
 		return
 	}
 
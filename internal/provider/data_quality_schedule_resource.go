@@ -0,0 +1,345 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*dataQualityScheduleResource)(nil)
+var _ resource.ResourceWithConfigure = (*dataQualityScheduleResource)(nil)
+var _ resource.ResourceWithImportState = (*dataQualityScheduleResource)(nil)
+
+func NewDataQualityScheduleResource() resource.Resource {
+	return &dataQualityScheduleResource{}
+}
+
+type dataQualityScheduleResource struct {
+	client *client.GalaxyClient
+}
+
+type dataQualityScheduleModel struct {
+	DataQualityScheduleId types.String `tfsdk:"data_quality_schedule_id"`
+	CatalogId             types.String `tfsdk:"catalog_id"`
+	SchemaId              types.String `tfsdk:"schema_id"`
+	TableId               types.String `tfsdk:"table_id"`
+	ClusterId             types.String `tfsdk:"cluster_id"`
+	RoleId                types.String `tfsdk:"role_id"`
+	CronExpression        types.String `tfsdk:"cron_expression"`
+	Timezone              types.String `tfsdk:"timezone"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	NextExecution         types.String `tfsdk:"next_execution"`
+	DataQualityCheckIds   types.List   `tfsdk:"data_quality_check_ids"`
+}
+
+func (r *dataQualityScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_quality_schedule"
+}
+
+func (r *dataQualityScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the data quality schedule of a table. A table has at most one schedule, which runs all data quality checks defined on the table.",
+		Attributes: map[string]schema.Attribute{
+			"data_quality_schedule_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Data Quality Schedule ID (read only)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// The schedule is addressed by its table, so moving it to another table must
+			// destroy and recreate it.
+			"catalog_id": schema.StringAttribute{
+				Required:    true,
+				Description: "A catalog",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema_id": schema.StringAttribute{
+				Required:    true,
+				Description: "A schema from a catalog",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table_id": schema.StringAttribute{
+				Required:    true,
+				Description: "A table from a catalog",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Cluster in which checks are executed",
+			},
+			"role_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Role used when checks are executed",
+			},
+			"cron_expression": schema.StringAttribute{
+				Required:    true,
+				Description: "Cron expression which determines execution interval",
+				Validators: []validator.String{
+					cronExpressionValidator{},
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("UTC"),
+				Description: "Timezone in which CRON is evaluated",
+				Validators: []validator.String{
+					timezoneValidator{},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Is schedule enabled",
+			},
+			"next_execution": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time of the next scheduled execution (read only)",
+			},
+			"data_quality_check_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the data quality checks run by this schedule (read only)",
+			},
+		},
+	}
+}
+
+func (r *dataQualityScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dataQualityScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dataQualityScheduleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.modelToRequest(&plan)
+
+	tflog.Debug(ctx, "Creating data quality schedule", map[string]interface{}{"table_id": plan.TableId.ValueString()})
+	response, err := r.client.CreateDataQualitySchedule(ctx, plan.CatalogId.ValueString(), plan.SchemaId.ValueString(), plan.TableId.ValueString(), request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating data quality schedule", "Could not create data quality schedule", err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Created data quality schedule", map[string]interface{}{"id": plan.DataQualityScheduleId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dataQualityScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataQualityScheduleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableID := state.TableId.ValueString()
+	tflog.Debug(ctx, "Reading data quality schedule", map[string]interface{}{"table_id": tableID})
+	response, err := r.client.GetDataQualitySchedule(ctx, state.CatalogId.ValueString(), state.SchemaId.ValueString(), tableID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Data quality schedule not found, removing from state", map[string]interface{}{"table_id": tableID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading data quality schedule",
+			"Could not read data quality schedule for table "+tableID+": "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &state, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dataQualityScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataQualityScheduleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.modelToRequest(&plan)
+
+	tableID := plan.TableId.ValueString()
+	tflog.Debug(ctx, "Updating data quality schedule", map[string]interface{}{"table_id": tableID})
+	response, err := r.client.UpdateDataQualitySchedule(ctx, plan.CatalogId.ValueString(), plan.SchemaId.ValueString(), tableID, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating data quality schedule", "Could not update data quality schedule for table "+tableID, err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updated data quality schedule", map[string]interface{}{"id": plan.DataQualityScheduleId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dataQualityScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataQualityScheduleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableID := state.TableId.ValueString()
+	tflog.Debug(ctx, "Deleting data quality schedule", map[string]interface{}{"table_id": tableID})
+	err := r.client.DeleteDataQualitySchedule(ctx, state.CatalogId.ValueString(), state.SchemaId.ValueString(), tableID)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting data quality schedule",
+				"Could not delete data quality schedule for table "+tableID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted data quality schedule", map[string]interface{}{"table_id": tableID})
+}
+
+func (r *dataQualityScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in the format catalog_id/schema_id/table_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("catalog_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schema_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("table_id"), parts[2])...)
+}
+
+// Helper methods
+func (r *dataQualityScheduleResource) modelToRequest(model *dataQualityScheduleModel) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	// catalog_id, schema_id and table_id are part of the request path.
+	request["clusterId"] = model.ClusterId.ValueString()
+	request["roleId"] = model.RoleId.ValueString()
+	request["cronExpression"] = model.CronExpression.ValueString()
+
+	if !model.Timezone.IsNull() && !model.Timezone.IsUnknown() {
+		request["timezone"] = model.Timezone.ValueString()
+	}
+	if !model.Enabled.IsNull() && !model.Enabled.IsUnknown() {
+		request["enabled"] = model.Enabled.ValueBool()
+	}
+
+	return request
+}
+
+func (r *dataQualityScheduleResource) updateModelFromResponse(ctx context.Context, model *dataQualityScheduleModel, response map[string]interface{}, diags *diag.Diagnostics) {
+	if dataQualityScheduleId, ok := response["dataQualityScheduleId"].(string); ok {
+		model.DataQualityScheduleId = types.StringValue(dataQualityScheduleId)
+	} else if model.DataQualityScheduleId.IsUnknown() {
+		model.DataQualityScheduleId = types.StringNull()
+	}
+	if clusterId, ok := response["clusterId"].(string); ok {
+		model.ClusterId = types.StringValue(clusterId)
+	}
+	if roleId, ok := response["roleId"].(string); ok {
+		model.RoleId = types.StringValue(roleId)
+	}
+	if cronExpression, ok := response["cronExpression"].(string); ok {
+		model.CronExpression = types.StringValue(cronExpression)
+	}
+	if timezone, ok := response["timezone"].(string); ok {
+		model.Timezone = types.StringValue(timezone)
+	}
+	if enabled, ok := response["enabled"].(bool); ok {
+		model.Enabled = types.BoolValue(enabled)
+	}
+
+	// next_execution is null while the schedule is disabled.
+	if nextExecution, ok := response["nextExecution"].(string); ok {
+		model.NextExecution = types.StringValue(nextExecution)
+	} else {
+		model.NextExecution = types.StringNull()
+	}
+
+	checkIDs := make([]attr.Value, 0)
+	if checks, ok := response["dataQualityChecks"].([]interface{}); ok {
+		for _, checkInterface := range checks {
+			if checkMap, ok := checkInterface.(map[string]interface{}); ok {
+				if id := getStringFromMap(checkMap, "dataQualityCheckId"); id != "" {
+					checkIDs = append(checkIDs, types.StringValue(id))
+				}
+			}
+		}
+	}
+	listValue, d := types.ListValue(types.StringType, checkIDs)
+	diags.Append(d...)
+	if !d.HasError() {
+		model.DataQualityCheckIds = listValue
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*entityTagsResource)(nil)
+var _ resource.ResourceWithConfigure = (*entityTagsResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*entityTagsResource)(nil)
+var _ resource.ResourceWithImportState = (*entityTagsResource)(nil)
+
+func NewEntityTagsResource() resource.Resource {
+	return &entityTagsResource{}
+}
+
+type entityTagsResource struct {
+	client *client.GalaxyClient
+}
+
+type entityTagsModel struct {
+	tagEntity
+	TagIds types.Set `tfsdk:"tag_ids"`
+}
+
+func (r *entityTagsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_tags"
+}
+
+func (r *entityTagsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := tagEntitySchemaAttributes()
+	attributes["tag_ids"] = schema.SetAttribute{
+		ElementType: types.StringType,
+		Required:    true,
+		Description: "IDs of all tags assigned to the entity. Tags not listed here are removed; an empty set removes every tag.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete set of tags on a catalog, schema, table or column. This is an authoritative resource: tags added outside this resource, including by galaxy_tag_assignment, are removed on the next apply. Destroying the resource removes all tags from the entity.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *entityTagsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config entityTagsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.tagEntity.validate(&resp.Diagnostics)
+}
+
+func (r *entityTagsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *entityTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan entityTagsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating entity_tags", map[string]interface{}{"entity": plan.tagPath()})
+	r.setTags(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *entityTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state entityTagsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagPath := state.tagPath()
+	tflog.Debug(ctx, "Reading entity_tags", map[string]interface{}{"entity": tagPath})
+
+	tags, err := r.client.GetEntityTags(ctx, tagPath)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Entity not found, removing entity_tags from state", map[string]interface{}{"entity": tagPath})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading entity tags",
+			"Could not read tags of "+state.Kind.ValueString()+" "+state.tagEntity.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	tagIDs, d := types.SetValueFrom(ctx, types.StringType, tagIDsFromTags(tags))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.TagIds = tagIDs
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *entityTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan entityTagsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating entity_tags", map[string]interface{}{"entity": plan.tagPath()})
+	r.setTags(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *entityTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state entityTagsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagPath := state.tagPath()
+	tflog.Debug(ctx, "Deleting entity_tags", map[string]interface{}{"entity": tagPath})
+
+	r.client.LockEntityTags(tagPath)
+	err := r.client.UpdateEntityTags(ctx, tagPath, []string{})
+	r.client.UnlockEntityTags(tagPath)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting entity tags",
+			"Could not remove tags from "+state.Kind.ValueString()+" "+state.tagEntity.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted entity_tags", map[string]interface{}{"entity": tagPath})
+}
+
+func (r *entityTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	entity, err := parseTagEntity(strings.Split(req.ID, "/"))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in the format catalog_id[/schema_id[/table_id[/column_id]]]",
+		)
+		return
+	}
+
+	// tag_ids is populated by the Read that follows import.
+	state := entityTagsModel{
+		tagEntity: entity,
+		TagIds:    types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Helper methods
+
+// setTags replaces the entity's tags with the planned set.
+func (r *entityTagsResource) setTags(ctx context.Context, model *entityTagsModel, diags *diag.Diagnostics) {
+	var tagIDs []string
+	diags.Append(model.TagIds.ElementsAs(ctx, &tagIDs, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	tagPath := model.tagPath()
+	r.client.LockEntityTags(tagPath)
+	err := r.client.UpdateEntityTags(ctx, tagPath, tagIDs)
+	r.client.UnlockEntityTags(tagPath)
+	if err != nil {
+		diags.AddError(
+			"Error updating entity tags",
+			"Could not set tags of "+model.Kind.ValueString()+" "+model.tagEntity.String()+": "+err.Error(),
+		)
+	}
+}
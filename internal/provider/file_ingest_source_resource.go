@@ -0,0 +1,322 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*fileIngestSourceResource)(nil)
+var _ resource.ResourceWithConfigure = (*fileIngestSourceResource)(nil)
+var _ resource.ResourceWithImportState = (*fileIngestSourceResource)(nil)
+
+func NewFileIngestSourceResource() resource.Resource {
+	return &fileIngestSourceResource{}
+}
+
+type fileIngestSourceResource struct {
+	client *client.GalaxyClient
+}
+
+type fileIngestSourceModel struct {
+	FileIngestSourceId types.String `tfsdk:"file_ingest_source_id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Bucket             types.String `tfsdk:"bucket"`
+	Prefix             types.String `tfsdk:"prefix"`
+	RoleArn            types.String `tfsdk:"role_arn"`
+	AccessKey          types.String `tfsdk:"access_key"`
+	SecretKey          types.String `tfsdk:"secret_key"`
+}
+
+func (r *fileIngestSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_ingest_source"
+}
+
+func (r *fileIngestSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a file ingest source that streams files from an object storage bucket into Galaxy. Authenticate with either role_arn (a cross-account IAM role) or access_key and secret_key.",
+		Attributes: map[string]schema.Attribute{
+			"file_ingest_source_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID (read only)",
+				// file_ingest_source_id is assigned at creation and never changes. Without
+				// UseStateForUnknown, any update marks it "known after apply", which propagates to
+				// ingest streams that reference the source.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Description",
+			},
+			"bucket": schema.StringAttribute{
+				Required:    true,
+				Description: "Storage bucket",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "File name prefix or path",
+			},
+			"role_arn": schema.StringAttribute{
+				Optional:    true,
+				Description: "AWS cross account role ARN",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_key"), path.MatchRoot("secret_key")),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "AWS access key",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("secret_key")),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS secret key",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("access_key")),
+				},
+			},
+		},
+	}
+}
+
+func (r *fileIngestSourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *fileIngestSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fileIngestSourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.modelToCreateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating file_ingest_source", map[string]interface{}{"name": plan.Name.ValueString()})
+	response, err := r.client.CreateFileIngestSource(ctx, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating file_ingest_source", "Could not create file_ingest_source", err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Created file_ingest_source", map[string]interface{}{"id": plan.FileIngestSourceId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *fileIngestSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fileIngestSourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.FileIngestSourceId.ValueString()
+	tflog.Debug(ctx, "Reading file_ingest_source", map[string]interface{}{"id": id})
+	response, err := r.client.GetFileIngestSource(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "File ingest source not found, removing from state", map[string]interface{}{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading file_ingest_source",
+			"Could not read file_ingest_source "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &state, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *fileIngestSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan fileIngestSourceModel
+	var state fileIngestSourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.FileIngestSourceId.ValueString()
+	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating file_ingest_source", map[string]interface{}{"id": id})
+	response, err := r.client.UpdateFileIngestSource(ctx, id, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating file_ingest_source", "Could not update file_ingest_source "+id, err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updated file_ingest_source", map[string]interface{}{"id": plan.FileIngestSourceId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *fileIngestSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state fileIngestSourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.FileIngestSourceId.ValueString()
+	tflog.Debug(ctx, "Deleting file_ingest_source", map[string]interface{}{"id": id})
+	err := r.client.DeleteFileIngestSource(ctx, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting file_ingest_source",
+				"Could not delete file_ingest_source "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted file_ingest_source", map[string]interface{}{"id": id})
+}
+
+func (r *fileIngestSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("file_ingest_source_id"), req, resp)
+}
+
+// Helper methods
+func (r *fileIngestSourceResource) modelToCreateRequest(ctx context.Context, model *fileIngestSourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	// Required fields
+	request["name"] = model.Name.ValueString()
+	request["bucket"] = model.Bucket.ValueString()
+
+	// Optional fields
+	if !model.Description.IsNull() && !model.Description.IsUnknown() && model.Description.ValueString() != "" {
+		request["description"] = model.Description.ValueString()
+	}
+	if !model.Prefix.IsNull() && !model.Prefix.IsUnknown() {
+		request["prefix"] = model.Prefix.ValueString()
+	}
+
+	// Authentication: role_arn and access_key/secret_key are mutually exclusive (enforced by
+	// schema validators).
+	if !model.RoleArn.IsNull() && !model.RoleArn.IsUnknown() && model.RoleArn.ValueString() != "" {
+		request["roleArn"] = model.RoleArn.ValueString()
+	} else {
+		if !model.AccessKey.IsNull() && !model.AccessKey.IsUnknown() && model.AccessKey.ValueString() != "" {
+			request["accessKey"] = model.AccessKey.ValueString()
+		}
+		if !model.SecretKey.IsNull() && !model.SecretKey.IsUnknown() && model.SecretKey.ValueString() != "" {
+			request["secretKey"] = model.SecretKey.ValueString()
+		}
+	}
+
+	return request
+}
+
+func (r *fileIngestSourceResource) modelToUpdateRequest(ctx context.Context, model *fileIngestSourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	return r.modelToCreateRequest(ctx, model, diags)
+}
+
+func (r *fileIngestSourceResource) updateModelFromResponse(ctx context.Context, model *fileIngestSourceModel, response map[string]interface{}, diags *diag.Diagnostics) {
+	if id, ok := response["fileIngestSourceId"].(string); ok {
+		model.FileIngestSourceId = types.StringValue(id)
+	}
+
+	if name, ok := response["name"].(string); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if description, ok := response["description"].(string); ok {
+		model.Description = types.StringValue(description)
+	} else {
+		model.Description = types.StringNull()
+	}
+
+	if bucket, ok := response["bucket"].(string); ok {
+		model.Bucket = types.StringValue(bucket)
+	}
+
+	// prefix and role_arn are Optional without Computed, so only take the API value when the
+	// user configured them; otherwise state would not match the configuration.
+	if prefix, ok := response["prefix"].(string); ok && !model.Prefix.IsNull() {
+		model.Prefix = types.StringValue(prefix)
+	}
+	if roleArn, ok := response["roleArn"].(string); ok && !model.RoleArn.IsNull() {
+		model.RoleArn = types.StringValue(roleArn)
+	}
+
+	// access_key and secret_key are never returned by the API; keep the configured values.
+}
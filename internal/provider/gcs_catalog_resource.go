@@ -130,10 +130,7 @@ func (r *gcs_catalogResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Debug(ctx, "Creating gcs_catalog")
 	response, err := r.client.CreateCatalog(ctx, "gcs", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating gcs_catalog",
-			"Could not create gcs_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating gcs_catalog", "Could not create gcs_catalog", err)
 		return
 	}
 
@@ -212,10 +209,7 @@ func (r *gcs_catalogResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Debug(ctx, "Updating gcs_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "gcs", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating gcs_catalog",
-			"Could not update gcs_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating gcs_catalog", "Could not update gcs_catalog "+id, err)
 		return
 	}
 
@@ -0,0 +1,73 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+// resourceSchema returns the schema of r.
+func resourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// schemaObject returns an object of the schema's type with every attribute null except those in
+// overrides. Override values are anything tftypes.NewValue accepts for the attribute's type,
+// such as a string, nil, tftypes.UnknownValue or a []tftypes.Value for a list.
+func schemaObject(t *testing.T, s schema.Schema, overrides map[string]interface{}) tftypes.Value {
+	t.Helper()
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range overrides {
+		attrType, ok := objectType.AttributeTypes[name]
+		if !ok {
+			t.Fatalf("schema has no attribute %q", name)
+		}
+		values[name] = tftypes.NewValue(attrType, value)
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// validateConfigRequest returns a ValidateConfigRequest for a configuration that sets only the
+// attributes in overrides.
+func validateConfigRequest(t *testing.T, s schema.Schema, overrides map[string]interface{}) resource.ValidateConfigRequest {
+	t.Helper()
+	return resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: schemaObject(t, s, overrides)},
+	}
+}
+
+// newFakeGalaxyClient returns a client for a local server answering with handler. The client
+// does not retry and bypasses the process-wide rate limiters, so tests neither wait on each
+// other nor change the budget seen by later tests.
+func newFakeGalaxyClient(t *testing.T, handler http.HandlerFunc) *client.GalaxyClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return client.NewGalaxyClient(server.URL, "", "", "test", client.Options{
+		AccessToken:       "test-token",
+		MaxRetries:        -1,
+		DisableRateLimits: true,
+	})
+}
@@ -0,0 +1,636 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*ingestStreamResource)(nil)
+var _ resource.ResourceWithConfigure = (*ingestStreamResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*ingestStreamResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*ingestStreamResource)(nil)
+var _ resource.ResourceWithImportState = (*ingestStreamResource)(nil)
+
+func NewIngestStreamResource() resource.Resource {
+	return &ingestStreamResource{}
+}
+
+type ingestStreamResource struct {
+	client *client.GalaxyClient
+}
+
+type ingestStreamModel struct {
+	IngestStreamId         types.String `tfsdk:"ingest_stream_id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	KafkaIngestSourceId    types.String `tfsdk:"kafka_ingest_source_id"`
+	Topic                  types.String `tfsdk:"topic"`
+	FileIngestSourceId     types.String `tfsdk:"file_ingest_source_id"`
+	FilePrefix             types.String `tfsdk:"file_prefix"`
+	CatalogId              types.String `tfsdk:"catalog_id"`
+	SchemaName             types.String `tfsdk:"schema_name"`
+	TableName              types.String `tfsdk:"table_name"`
+	MessageFormat          types.String `tfsdk:"message_format"`
+	SchemaRegistryUrl      types.String `tfsdk:"schema_registry_url"`
+	SchemaRegistryUsername types.String `tfsdk:"schema_registry_username"`
+	SchemaRegistryPassword types.String `tfsdk:"schema_registry_password"`
+	CsvDelimiter           types.String `tfsdk:"csv_delimiter"`
+	State                  types.String `tfsdk:"state"`
+}
+
+// ingestStreamTargetCatalogTypes lists the catalog types an ingest stream can write to. Streams
+// land data as files in object storage, so only S3 and GCS catalogs are valid targets.
+var ingestStreamTargetCatalogTypes = []string{"s3", "gcs"}
+
+func (r *ingestStreamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingest_stream"
+}
+
+func (r *ingestStreamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an ingest stream that continuously loads a Kafka topic or a file prefix from an ingest source into a table of an S3 or GCS catalog.",
+		Attributes: map[string]schema.Attribute{
+			"ingest_stream_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Ingest stream ID (read only)",
+				// ingest_stream_id is assigned at creation and never changes. Without
+				// UseStateForUnknown, any update (for example stopping the stream) marks it
+				// "known after apply".
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Ingest stream name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Ingest stream description",
+			},
+			"kafka_ingest_source_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the Kafka ingest source to read from. Exactly one of kafka_ingest_source_id or file_ingest_source_id must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("file_ingest_source_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"topic": schema.StringAttribute{
+				Optional:    true,
+				Description: "Kafka topic to consume. Required with kafka_ingest_source_id.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("kafka_ingest_source_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_ingest_source_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the file ingest source to read from. Exactly one of kafka_ingest_source_id or file_ingest_source_id must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Object key prefix, relative to the file ingest source prefix, to load files from",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("file_ingest_source_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalog_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the target catalog. Must be a catalog managed by galaxy_s3_catalog or galaxy_gcs_catalog.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Target schema name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Target table name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_format": schema.StringAttribute{
+				Required:    true,
+				Description: "Format of the ingested messages or files: JSON, AVRO or CSV",
+				Validators: []validator.String{
+					stringvalidator.OneOf("JSON", "AVRO", "CSV"),
+				},
+			},
+			"schema_registry_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schema registry URL used to decode Avro messages. Required when message_format is AVRO.",
+			},
+			"schema_registry_username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schema registry username",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("schema_registry_password")),
+				},
+			},
+			"schema_registry_password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Schema registry password",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("schema_registry_username")),
+				},
+			},
+			"csv_delimiter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Field delimiter for CSV input. Only valid when message_format is CSV; the service defaults to a comma.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("RUNNING"),
+				Description: "Desired stream state: RUNNING or STOPPED",
+				Validators: []validator.String{
+					stringvalidator.OneOf("RUNNING", "STOPPED"),
+				},
+			},
+		},
+	}
+}
+
+func (r *ingestStreamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ingestStreamModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.KafkaIngestSourceId.IsNull() && config.Topic.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("topic"),
+			"Missing topic",
+			"topic is required when kafka_ingest_source_id is set.",
+		)
+	}
+
+	if config.MessageFormat.IsUnknown() {
+		return
+	}
+	format := config.MessageFormat.ValueString()
+
+	if format == "AVRO" && config.SchemaRegistryUrl.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schema_registry_url"),
+			"Missing schema registry",
+			"schema_registry_url is required when message_format is AVRO.",
+		)
+	}
+	if format != "AVRO" {
+		registryAttributes := map[string]types.String{
+			"schema_registry_url":      config.SchemaRegistryUrl,
+			"schema_registry_username": config.SchemaRegistryUsername,
+			"schema_registry_password": config.SchemaRegistryPassword,
+		}
+		for name, value := range registryAttributes {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Unexpected schema registry setting",
+					name+" can only be set when message_format is AVRO.",
+				)
+			}
+		}
+	}
+
+	if format != "CSV" && !config.CsvDelimiter.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("csv_delimiter"),
+			"Unexpected CSV setting",
+			"csv_delimiter can only be set when message_format is CSV.",
+		)
+	}
+}
+
+// ModifyPlan verifies that the target catalog is an S3 or GCS catalog. The check only runs
+// when catalog_id is known at plan time and differs from state, so a catalog created in the
+// same apply is checked by the API instead.
+func (r *ingestStreamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If plan is null (destroy) exit
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ingestStreamModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CatalogId.IsUnknown() || plan.CatalogId.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ingestStreamModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.CatalogId.Equal(plan.CatalogId) {
+			return
+		}
+	}
+
+	catalogID := plan.CatalogId.ValueString()
+	catalogType, err := r.lookupTargetCatalogType(ctx, catalogID)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("catalog_id"),
+			"Could not verify target catalog",
+			"Could not look up catalog "+catalogID+" to verify it is an S3 or GCS catalog: "+err.Error(),
+		)
+		return
+	}
+	if catalogType == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("catalog_id"),
+			"Invalid target catalog",
+			"Catalog "+catalogID+" is not an S3 or GCS catalog. Ingest streams can only write to catalogs "+
+				"managed by galaxy_s3_catalog or galaxy_gcs_catalog.",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Verified ingest_stream target catalog", map[string]interface{}{"catalog_id": catalogID, "catalog_type": catalogType})
+}
+
+func (r *ingestStreamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ingestStreamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ingestStreamModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.modelToCreateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating ingest_stream", map[string]interface{}{"name": plan.Name.ValueString()})
+	response, err := r.client.CreateIngestStream(ctx, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating ingest_stream", "Could not create ingest_stream", err)
+		return
+	}
+
+	desiredState := plan.State.ValueString()
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Persist the stream before changing its state so a failed start does not orphan it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyState(ctx, &plan, desiredState, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Created ingest_stream", map[string]interface{}{"id": plan.IngestStreamId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ingestStreamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ingestStreamModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.IngestStreamId.ValueString()
+	tflog.Debug(ctx, "Reading ingest_stream", map[string]interface{}{"id": id})
+	response, err := r.client.GetIngestStream(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Ingest stream not found, removing from state", map[string]interface{}{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading ingest_stream",
+			"Could not read ingest_stream "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &state, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ingestStreamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ingestStreamModel
+	var state ingestStreamModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.IngestStreamId.ValueString()
+	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating ingest_stream", map[string]interface{}{"id": id})
+	response, err := r.client.UpdateIngestStream(ctx, id, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating ingest_stream", "Could not update ingest_stream "+id, err)
+		return
+	}
+
+	desiredState := plan.State.ValueString()
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyState(ctx, &plan, desiredState, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updated ingest_stream", map[string]interface{}{"id": plan.IngestStreamId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ingestStreamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ingestStreamModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.IngestStreamId.ValueString()
+	tflog.Debug(ctx, "Deleting ingest_stream", map[string]interface{}{"id": id})
+	err := r.client.DeleteIngestStream(ctx, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting ingest_stream",
+				"Could not delete ingest_stream "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted ingest_stream", map[string]interface{}{"id": id})
+}
+
+func (r *ingestStreamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ingest_stream_id"), req, resp)
+}
+
+// Helper methods
+
+// lookupTargetCatalogType returns the object-store catalog type ("s3" or "gcs") of catalogID,
+// or "" when the catalog exists under neither type.
+func (r *ingestStreamResource) lookupTargetCatalogType(ctx context.Context, catalogID string) (string, error) {
+	for _, catalogType := range ingestStreamTargetCatalogTypes {
+		_, err := r.client.GetCatalog(ctx, catalogType, catalogID)
+		if err == nil {
+			return catalogType, nil
+		}
+		if !client.IsNotFound(err) {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// applyState starts or stops the stream when its current state differs from desiredState.
+// Transitional states reported by the API (STARTING, STOPPING) count as already converging.
+func (r *ingestStreamResource) applyState(ctx context.Context, model *ingestStreamModel, desiredState string, diags *diag.Diagnostics) {
+	current := model.State.ValueString()
+	if current == desiredState {
+		return
+	}
+
+	id := model.IngestStreamId.ValueString()
+	var response map[string]interface{}
+	var err error
+	switch desiredState {
+	case "RUNNING":
+		tflog.Debug(ctx, "Starting ingest_stream", map[string]interface{}{"id": id, "current_state": current})
+		response, err = r.client.StartIngestStream(ctx, id)
+	case "STOPPED":
+		tflog.Debug(ctx, "Stopping ingest_stream", map[string]interface{}{"id": id, "current_state": current})
+		response, err = r.client.StopIngestStream(ctx, id)
+	default:
+		return
+	}
+	if err != nil {
+		diags.AddError(
+			"Error changing ingest_stream state",
+			"Could not change ingest_stream "+id+" to "+desiredState+": "+err.Error(),
+		)
+		return
+	}
+
+	if response != nil {
+		r.updateModelFromResponse(ctx, model, response, diags)
+	}
+	// Start and stop are asynchronous; record the requested state so the plan converges.
+	model.State = types.StringValue(desiredState)
+}
+
+func (r *ingestStreamResource) modelToCreateRequest(ctx context.Context, model *ingestStreamModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := r.modelToUpdateRequest(ctx, model, diags)
+
+	// Source and target are immutable and only sent on create.
+	if !model.KafkaIngestSourceId.IsNull() && !model.KafkaIngestSourceId.IsUnknown() {
+		request["kafkaIngestSourceId"] = model.KafkaIngestSourceId.ValueString()
+		request["topic"] = model.Topic.ValueString()
+	}
+	if !model.FileIngestSourceId.IsNull() && !model.FileIngestSourceId.IsUnknown() {
+		request["fileIngestSourceId"] = model.FileIngestSourceId.ValueString()
+		if !model.FilePrefix.IsNull() && !model.FilePrefix.IsUnknown() && model.FilePrefix.ValueString() != "" {
+			request["filePrefix"] = model.FilePrefix.ValueString()
+		}
+	}
+	request["catalogId"] = model.CatalogId.ValueString()
+	request["schemaName"] = model.SchemaName.ValueString()
+	request["tableName"] = model.TableName.ValueString()
+
+	return request
+}
+
+func (r *ingestStreamResource) modelToUpdateRequest(ctx context.Context, model *ingestStreamModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	// Required fields
+	request["name"] = model.Name.ValueString()
+	request["messageFormat"] = model.MessageFormat.ValueString()
+
+	// Optional fields
+	if !model.Description.IsNull() && !model.Description.IsUnknown() && model.Description.ValueString() != "" {
+		request["description"] = model.Description.ValueString()
+	}
+	switch model.MessageFormat.ValueString() {
+	case "AVRO":
+		if !model.SchemaRegistryUrl.IsNull() && !model.SchemaRegistryUrl.IsUnknown() {
+			request["schemaRegistryUrl"] = model.SchemaRegistryUrl.ValueString()
+		}
+		if !model.SchemaRegistryUsername.IsNull() && !model.SchemaRegistryUsername.IsUnknown() {
+			request["schemaRegistryUsername"] = model.SchemaRegistryUsername.ValueString()
+		}
+		if !model.SchemaRegistryPassword.IsNull() && !model.SchemaRegistryPassword.IsUnknown() {
+			request["schemaRegistryPassword"] = model.SchemaRegistryPassword.ValueString()
+		}
+	case "CSV":
+		if !model.CsvDelimiter.IsNull() && !model.CsvDelimiter.IsUnknown() {
+			request["csvDelimiter"] = model.CsvDelimiter.ValueString()
+		}
+	}
+
+	return request
+}
+
+func (r *ingestStreamResource) updateModelFromResponse(ctx context.Context, model *ingestStreamModel, response map[string]interface{}, diags *diag.Diagnostics) {
+	if id, ok := response["ingestStreamId"].(string); ok {
+		model.IngestStreamId = types.StringValue(id)
+	}
+
+	if name, ok := response["name"].(string); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if description, ok := response["description"].(string); ok {
+		model.Description = types.StringValue(description)
+	} else {
+		model.Description = types.StringNull()
+	}
+
+	if sourceID, ok := response["kafkaIngestSourceId"].(string); ok && sourceID != "" {
+		model.KafkaIngestSourceId = types.StringValue(sourceID)
+	}
+	if topic, ok := response["topic"].(string); ok && topic != "" {
+		model.Topic = types.StringValue(topic)
+	}
+	if sourceID, ok := response["fileIngestSourceId"].(string); ok && sourceID != "" {
+		model.FileIngestSourceId = types.StringValue(sourceID)
+	}
+	// file_prefix is Optional without Computed; only take the API value when configured.
+	if prefix, ok := response["filePrefix"].(string); ok && !model.FilePrefix.IsNull() {
+		model.FilePrefix = types.StringValue(prefix)
+	}
+
+	if catalogID, ok := response["catalogId"].(string); ok {
+		model.CatalogId = types.StringValue(catalogID)
+	}
+	if schemaName, ok := response["schemaName"].(string); ok {
+		model.SchemaName = types.StringValue(schemaName)
+	}
+	if tableName, ok := response["tableName"].(string); ok {
+		model.TableName = types.StringValue(tableName)
+	}
+
+	if format, ok := response["messageFormat"].(string); ok {
+		model.MessageFormat = types.StringValue(format)
+	}
+	if registryURL, ok := response["schemaRegistryUrl"].(string); ok && !model.SchemaRegistryUrl.IsNull() {
+		model.SchemaRegistryUrl = types.StringValue(registryURL)
+	}
+	if registryUsername, ok := response["schemaRegistryUsername"].(string); ok && !model.SchemaRegistryUsername.IsNull() {
+		model.SchemaRegistryUsername = types.StringValue(registryUsername)
+	}
+	// schema_registry_password is never returned by the API; keep the configured value.
+	if delimiter, ok := response["csvDelimiter"].(string); ok && !model.CsvDelimiter.IsNull() {
+		model.CsvDelimiter = types.StringValue(delimiter)
+	}
+
+	// Report transitional states as the state they are converging to. Any other value
+	// (for example FAILED) is kept so the plan shows drift back to the configured state.
+	if state, ok := response["state"].(string); ok {
+		switch state {
+		case "STARTING":
+			state = "RUNNING"
+		case "STOPPING":
+			state = "STOPPED"
+		}
+		model.State = types.StringValue(state)
+	}
+}
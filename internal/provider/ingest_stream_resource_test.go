@@ -0,0 +1,52 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestIngestStreamValidateConfigMessageFormat(t *testing.T) {
+	cases := []struct {
+		name        string
+		format      string
+		topic       interface{}
+		registryURL interface{}
+		delimiter   interface{}
+		wantError   bool
+	}{
+		{"json", "JSON", "events", nil, nil, false},
+		{"kafka without topic", "JSON", nil, nil, nil, true},
+		{"avro with registry", "AVRO", "events", "https://registry.example.com", nil, false},
+		{"avro without registry", "AVRO", "events", nil, nil, true},
+		{"json with registry", "JSON", "events", "https://registry.example.com", nil, true},
+		{"csv with delimiter", "CSV", "events", nil, ";", false},
+		{"json with delimiter", "JSON", "events", nil, ";", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &ingestStreamResource{}
+			req := validateConfigRequest(t, resourceSchema(t, r), map[string]interface{}{
+				"name":                   "stream",
+				"kafka_ingest_source_id": "kis-1",
+				"catalog_id":             "c-1",
+				"schema_name":            "raw",
+				"table_name":             "events",
+				"message_format":         tc.format,
+				"topic":                  tc.topic,
+				"schema_registry_url":    tc.registryURL,
+				"csv_delimiter":          tc.delimiter,
+			})
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("expected error=%t, got diagnostics: %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ datasource.DataSource = (*kafkaIngestSourceDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kafkaIngestSourceDataSource)(nil)
+
+func NewKafkaIngestSourceDataSource() datasource.DataSource {
+	return &kafkaIngestSourceDataSource{}
+}
+
+type kafkaIngestSourceDataSource struct {
+	client *client.GalaxyClient
+}
+
+// kafkaIngestSourceDataSourceModel is kafkaIngestSourceModel without the write-only password.
+type kafkaIngestSourceDataSourceModel struct {
+	KafkaIngestSourceId types.String `tfsdk:"kafka_ingest_source_id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	KafkaBrokers        types.List   `tfsdk:"kafka_brokers"`
+	AuthenticationType  types.String `tfsdk:"authentication_type"`
+	TlsEnabled          types.Bool   `tfsdk:"tls_enabled"`
+	SaslUsername        types.String `tfsdk:"sasl_username"`
+}
+
+func (d *kafkaIngestSourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_ingest_source"
+}
+
+func (d *kafkaIngestSourceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kafka_ingest_source_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Ingest source ID",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Ingest source name (read only)",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Ingest source description (read only)",
+			},
+			"kafka_brokers": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Kafka brokers for this source (read only)",
+			},
+			"authentication_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Authentication mechanism (read only)",
+			},
+			"tls_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Connect to the brokers over TLS (read only)",
+			},
+			"sasl_username": schema.StringAttribute{
+				Computed:    true,
+				Description: "SASL username (read only)",
+			},
+		},
+	}
+}
+
+func (d *kafkaIngestSourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kafkaIngestSourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config kafkaIngestSourceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.KafkaIngestSourceId.ValueString()
+
+	tflog.Debug(ctx, "Reading kafka_ingest_source data source", map[string]interface{}{"id": id})
+	response, err := d.client.GetKafkaIngestSource(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading kafka_ingest_source",
+			"Could not read kafka_ingest_source "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	if name, ok := response["name"].(string); ok {
+		config.Name = types.StringValue(name)
+	} else {
+		config.Name = types.StringNull()
+	}
+	if description, ok := response["description"].(string); ok {
+		config.Description = types.StringValue(description)
+	} else {
+		config.Description = types.StringNull()
+	}
+	if authType, ok := response["authenticationType"].(string); ok {
+		config.AuthenticationType = types.StringValue(authType)
+	} else {
+		config.AuthenticationType = types.StringNull()
+	}
+	if username, ok := response["saslUsername"].(string); ok {
+		config.SaslUsername = types.StringValue(username)
+	} else {
+		config.SaslUsername = types.StringNull()
+	}
+
+	if tlsEnabled, ok := response["tlsEnabled"].(bool); ok {
+		config.TlsEnabled = types.BoolValue(tlsEnabled)
+	} else {
+		config.TlsEnabled = types.BoolNull()
+	}
+
+	brokers := make([]string, 0)
+	if brokersRaw, ok := response["kafkaBrokers"].([]interface{}); ok {
+		for _, b := range brokersRaw {
+			if s, ok := b.(string); ok {
+				brokers = append(brokers, s)
+			}
+		}
+	}
+	listValue, diags := types.ListValueFrom(ctx, types.StringType, brokers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.KafkaBrokers = listValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,397 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*kafkaIngestSourceResource)(nil)
+var _ resource.ResourceWithConfigure = (*kafkaIngestSourceResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*kafkaIngestSourceResource)(nil)
+var _ resource.ResourceWithImportState = (*kafkaIngestSourceResource)(nil)
+
+func NewKafkaIngestSourceResource() resource.Resource {
+	return &kafkaIngestSourceResource{}
+}
+
+type kafkaIngestSourceResource struct {
+	client *client.GalaxyClient
+}
+
+type kafkaIngestSourceModel struct {
+	KafkaIngestSourceId types.String `tfsdk:"kafka_ingest_source_id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	KafkaBrokers        types.List   `tfsdk:"kafka_brokers"`
+	AuthenticationType  types.String `tfsdk:"authentication_type"`
+	TlsEnabled          types.Bool   `tfsdk:"tls_enabled"`
+	SaslUsername        types.String `tfsdk:"sasl_username"`
+	SaslPassword        types.String `tfsdk:"sasl_password"`
+}
+
+// kafkaAuthenticationTypes lists the supported values of authentication_type. Every type other
+// than NONE authenticates with SASL and therefore requires sasl_username and sasl_password.
+var kafkaAuthenticationTypes = []string{
+	"NONE",
+	"SASL_PLAIN",
+	"SASL_SCRAM_SHA_256",
+	"SASL_SCRAM_SHA_512",
+}
+
+func (r *kafkaIngestSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_ingest_source"
+}
+
+func (r *kafkaIngestSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Kafka ingest source that streams topics from a Kafka cluster into Galaxy.",
+		Attributes: map[string]schema.Attribute{
+			"kafka_ingest_source_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Ingest source ID (read only)",
+				// kafka_ingest_source_id is assigned at creation and never changes. Without
+				// UseStateForUnknown, any update marks it "known after apply", which propagates to
+				// ingest streams that reference the source.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Ingest source name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Ingest source description",
+			},
+			"kafka_brokers": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Kafka brokers for this source, as host:port",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"authentication_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("SASL_PLAIN"),
+				Description: "Authentication mechanism: NONE, SASL_PLAIN, SASL_SCRAM_SHA_256 or SASL_SCRAM_SHA_512",
+				Validators: []validator.String{
+					stringvalidator.OneOf(kafkaAuthenticationTypes...),
+				},
+			},
+			"tls_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Connect to the brokers over TLS",
+			},
+			"sasl_username": schema.StringAttribute{
+				Optional:    true,
+				Description: "SASL username (API key for Confluent Cloud)",
+			},
+			"sasl_password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "SASL password (API secret for Confluent Cloud)",
+			},
+		},
+	}
+}
+
+func (r *kafkaIngestSourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config kafkaIngestSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// authentication_type may be unknown (e.g. from a variable) or null (schema default applies
+	// after validation, so null means SASL_PLAIN).
+	if config.AuthenticationType.IsUnknown() {
+		return
+	}
+	authType := config.AuthenticationType.ValueString()
+	if config.AuthenticationType.IsNull() {
+		authType = "SASL_PLAIN"
+	}
+
+	saslAttributes := map[string]types.String{
+		"sasl_username": config.SaslUsername,
+		"sasl_password": config.SaslPassword,
+	}
+	for name, value := range saslAttributes {
+		switch {
+		case authType == "NONE" && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unexpected SASL credentials",
+				name+" cannot be set when authentication_type is NONE.",
+			)
+		case authType != "NONE" && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing SASL credentials",
+				name+" is required when authentication_type is "+authType+".",
+			)
+		}
+	}
+}
+
+func (r *kafkaIngestSourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *kafkaIngestSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan kafkaIngestSourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.modelToCreateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating kafka_ingest_source", map[string]interface{}{"name": plan.Name.ValueString()})
+	response, err := r.client.CreateKafkaIngestSource(ctx, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating kafka_ingest_source", "Could not create kafka_ingest_source", err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Created kafka_ingest_source", map[string]interface{}{"id": plan.KafkaIngestSourceId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *kafkaIngestSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state kafkaIngestSourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.KafkaIngestSourceId.ValueString()
+	tflog.Debug(ctx, "Reading kafka_ingest_source", map[string]interface{}{"id": id})
+	response, err := r.client.GetKafkaIngestSource(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Kafka ingest source not found, removing from state", map[string]interface{}{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading kafka_ingest_source",
+			"Could not read kafka_ingest_source "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &state, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *kafkaIngestSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan kafkaIngestSourceModel
+	var state kafkaIngestSourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.KafkaIngestSourceId.ValueString()
+	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating kafka_ingest_source", map[string]interface{}{"id": id})
+	response, err := r.client.UpdateKafkaIngestSource(ctx, id, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating kafka_ingest_source", "Could not update kafka_ingest_source "+id, err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updated kafka_ingest_source", map[string]interface{}{"id": plan.KafkaIngestSourceId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *kafkaIngestSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state kafkaIngestSourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.KafkaIngestSourceId.ValueString()
+	tflog.Debug(ctx, "Deleting kafka_ingest_source", map[string]interface{}{"id": id})
+	err := r.client.DeleteKafkaIngestSource(ctx, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting kafka_ingest_source",
+				"Could not delete kafka_ingest_source "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted kafka_ingest_source", map[string]interface{}{"id": id})
+}
+
+func (r *kafkaIngestSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("kafka_ingest_source_id"), req, resp)
+}
+
+// Helper methods
+func (r *kafkaIngestSourceResource) modelToCreateRequest(ctx context.Context, model *kafkaIngestSourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	// Required fields
+	request["name"] = model.Name.ValueString()
+
+	brokers, d := stringListElements(ctx, model.KafkaBrokers)
+	diags.Append(d...)
+	request["kafkaBrokers"] = brokers
+
+	// Optional fields
+	if !model.Description.IsNull() && !model.Description.IsUnknown() && model.Description.ValueString() != "" {
+		request["description"] = model.Description.ValueString()
+	}
+	if !model.AuthenticationType.IsNull() && !model.AuthenticationType.IsUnknown() {
+		request["authenticationType"] = model.AuthenticationType.ValueString()
+	}
+	if !model.TlsEnabled.IsNull() && !model.TlsEnabled.IsUnknown() {
+		request["tlsEnabled"] = model.TlsEnabled.ValueBool()
+	}
+	if model.AuthenticationType.ValueString() != "NONE" {
+		if !model.SaslUsername.IsNull() && !model.SaslUsername.IsUnknown() {
+			request["saslUsername"] = model.SaslUsername.ValueString()
+		}
+		if !model.SaslPassword.IsNull() && !model.SaslPassword.IsUnknown() {
+			request["saslPassword"] = model.SaslPassword.ValueString()
+		}
+	}
+
+	return request
+}
+
+func (r *kafkaIngestSourceResource) modelToUpdateRequest(ctx context.Context, model *kafkaIngestSourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	return r.modelToCreateRequest(ctx, model, diags)
+}
+
+func (r *kafkaIngestSourceResource) updateModelFromResponse(ctx context.Context, model *kafkaIngestSourceModel, response map[string]interface{}, diags *diag.Diagnostics) {
+	if id, ok := response["kafkaIngestSourceId"].(string); ok {
+		model.KafkaIngestSourceId = types.StringValue(id)
+	}
+
+	if name, ok := response["name"].(string); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if description, ok := response["description"].(string); ok {
+		model.Description = types.StringValue(description)
+	} else {
+		model.Description = types.StringNull()
+	}
+
+	// kafka_brokers is Required; the API does not preserve submission order.
+	if brokersRaw, ok := response["kafkaBrokers"].([]interface{}); ok {
+		planned, d := stringListElements(ctx, model.KafkaBrokers)
+		diags.Append(d...)
+
+		brokers := make([]string, 0, len(brokersRaw))
+		for _, b := range brokersRaw {
+			if s, ok := b.(string); ok {
+				brokers = append(brokers, s)
+			}
+		}
+		brokers = reorderToMatchPlan(planned, brokers)
+
+		listValue, d := types.ListValueFrom(ctx, types.StringType, brokers)
+		diags.Append(d...)
+		if !d.HasError() {
+			model.KafkaBrokers = listValue
+		}
+	}
+
+	if authType, ok := response["authenticationType"].(string); ok {
+		model.AuthenticationType = types.StringValue(authType)
+	}
+
+	if tlsEnabled, ok := response["tlsEnabled"].(bool); ok {
+		model.TlsEnabled = types.BoolValue(tlsEnabled)
+	}
+
+	// sasl_username is Optional without Computed; only take the API value when configured.
+	if username, ok := response["saslUsername"].(string); ok && !model.SaslUsername.IsNull() {
+		model.SaslUsername = types.StringValue(username)
+	}
+
+	// sasl_password is never returned by the API; keep the configured value.
+}
@@ -0,0 +1,46 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestKafkaIngestSourceValidateConfigSaslCredentials(t *testing.T) {
+	cases := []struct {
+		name      string
+		authType  interface{}
+		username  interface{}
+		password  interface{}
+		wantError bool
+	}{
+		{"default auth with credentials", nil, "key", "secret", false},
+		{"default auth without credentials", nil, nil, nil, true},
+		{"scram without password", "SASL_SCRAM_SHA_512", "key", nil, true},
+		{"none without credentials", "NONE", nil, nil, false},
+		{"none with credentials", "NONE", "key", "secret", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &kafkaIngestSourceResource{}
+			req := validateConfigRequest(t, resourceSchema(t, r), map[string]interface{}{
+				"name":                "kafka",
+				"kafka_brokers":       []tftypes.Value{tftypes.NewValue(tftypes.String, "broker:9092")},
+				"authentication_type": tc.authType,
+				"sasl_username":       tc.username,
+				"sasl_password":       tc.password,
+			})
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("expected error=%t, got diagnostics: %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -153,13 +153,10 @@ func (r *mongodb_catalogResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	tflog.Debug(ctx, "Creating mongodb_catalog", map[string]interface{}{"request": request})
+	tflog.Debug(ctx, "Creating mongodb_catalog", map[string]interface{}{"name": plan.Name.ValueString()})
 	response, err := r.client.CreateCatalog(ctx, "mongodb", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating mongodb_catalog",
-			"Could not create mongodb_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating mongodb_catalog", "Could not create mongodb_catalog", err)
 		return
 	}
 
@@ -236,10 +233,7 @@ func (r *mongodb_catalogResource) Update(ctx context.Context, req resource.Updat
 	tflog.Debug(ctx, "Updating mongodb_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "mongodb", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating mongodb_catalog",
-			"Could not update mongodb_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating mongodb_catalog", "Could not update mongodb_catalog "+id, err)
 		return
 	}
 
@@ -125,10 +125,7 @@ func (r *mysql_catalogResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Debug(ctx, "Creating mysql_catalog")
 	response, err := r.client.CreateCatalog(ctx, "mysql", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating mysql_catalog",
-			"Could not create mysql_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating mysql_catalog", "Could not create mysql_catalog", err)
 		return
 	}
 
@@ -205,10 +202,7 @@ func (r *mysql_catalogResource) Update(ctx context.Context, req resource.UpdateR
 	tflog.Debug(ctx, "Updating mysql_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "mysql", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating mysql_catalog",
-			"Could not update mysql_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating mysql_catalog", "Could not update mysql_catalog "+id, err)
 		return
 	}
 
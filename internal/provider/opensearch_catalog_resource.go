@@ -93,27 +93,23 @@ func (r *opensearch_catalogResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	// password is WriteOnly: read from req.Config.
+	// password, access_key and secret_key are WriteOnly: read from req.Config.
 	var config resource_opensearch_catalog.OpensearchCatalogModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	plan.Password = config.Password
+	plan.AccessKey = config.AccessKey
+	plan.SecretKey = config.SecretKey
 
 	// Initialize unknown values to null for optional fields
-	if plan.AccessKey.IsUnknown() {
-		plan.AccessKey = types.StringNull()
-	}
 	if plan.Region.IsUnknown() {
 		plan.Region = types.StringNull()
 	}
 	if plan.RoleArn.IsUnknown() {
 		plan.RoleArn = types.StringNull()
 	}
-	if plan.SecretKey.IsUnknown() {
-		plan.SecretKey = types.StringNull()
-	}
 	if plan.SshTunnelId.IsUnknown() {
 		plan.SshTunnelId = types.StringNull()
 	}
@@ -126,10 +122,7 @@ func (r *opensearch_catalogResource) Create(ctx context.Context, req resource.Cr
 	tflog.Debug(ctx, "Creating opensearch_catalog")
 	response, err := r.client.CreateCatalog(ctx, "opensearch", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating opensearch_catalog",
-			"Could not create opensearch_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating opensearch_catalog", "Could not create opensearch_catalog", err)
 		return
 	}
 
@@ -189,13 +182,15 @@ func (r *opensearch_catalogResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	// password is WriteOnly: read from req.Config.
+	// password, access_key and secret_key are WriteOnly: read from req.Config.
 	var config resource_opensearch_catalog.OpensearchCatalogModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	plan.Password = config.Password
+	plan.AccessKey = config.AccessKey
+	plan.SecretKey = config.SecretKey
 
 	id := state.CatalogId.ValueString()
 	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
@@ -206,10 +201,7 @@ func (r *opensearch_catalogResource) Update(ctx context.Context, req resource.Up
 	tflog.Debug(ctx, "Updating opensearch_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "opensearch", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating opensearch_catalog",
-			"Could not update opensearch_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating opensearch_catalog", "Could not update opensearch_catalog "+id, err)
 		return
 	}
 
@@ -352,13 +344,7 @@ func (r *opensearch_catalogResource) updateModelFromResponse(ctx context.Context
 		model.Username = types.StringValue(username)
 	}
 
-	// Password is write-only, keep existing value
-
-	if accessKey, ok := response["accessKey"].(string); ok && accessKey != "" {
-		model.AccessKey = types.StringValue(accessKey)
-	} else {
-		model.AccessKey = types.StringNull()
-	}
+	// Password, access key and secret key are write-only, keep existing values
 
 	if region, ok := response["region"].(string); ok && region != "" {
 		model.Region = types.StringValue(region)
@@ -372,12 +358,6 @@ func (r *opensearch_catalogResource) updateModelFromResponse(ctx context.Context
 		model.RoleArn = types.StringNull()
 	}
 
-	if secretKey, ok := response["secretKey"].(string); ok && secretKey != "" {
-		model.SecretKey = types.StringValue(secretKey)
-	} else {
-		model.SecretKey = types.StringNull()
-	}
-
 	if sshTunnelId, ok := response["sshTunnelId"].(string); ok && sshTunnelId != "" {
 		model.SshTunnelId = types.StringValue(sshTunnelId)
 	} else {
@@ -103,10 +103,7 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Debug(ctx, "Creating policy")
 	response, err := r.client.CreatePolicy(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating policy",
-			"Could not create policy: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating policy", "Could not create policy", err)
 		return
 	}
 
@@ -183,13 +180,10 @@ func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	tflog.Debug(ctx, "Updating policy", map[string]interface{}{"id": id, "request": request})
+	tflog.Debug(ctx, "Updating policy", map[string]interface{}{"id": id})
 	response, err := r.client.UpdatePolicy(ctx, id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating policy",
-			"Could not update policy "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating policy", "Could not update policy "+id, err)
 		return
 	}
 
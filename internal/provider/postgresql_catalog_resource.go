@@ -130,10 +130,7 @@ func (r *postgresql_catalogResource) Create(ctx context.Context, req resource.Cr
 	tflog.Debug(ctx, "Creating postgresql_catalog")
 	response, err := r.client.CreateCatalog(ctx, "postgresql", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating postgresql_catalog",
-			"Could not create postgresql_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating postgresql_catalog", "Could not create postgresql_catalog", err)
 		return
 	}
 
@@ -210,10 +207,7 @@ func (r *postgresql_catalogResource) Update(ctx context.Context, req resource.Up
 	tflog.Debug(ctx, "Updating postgresql_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "postgresql", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating postgresql_catalog",
-			"Could not update postgresql_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating postgresql_catalog", "Could not update postgresql_catalog "+id, err)
 		return
 	}
 
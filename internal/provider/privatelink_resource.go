@@ -0,0 +1,428 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*privatelinkResource)(nil)
+var _ resource.ResourceWithConfigure = (*privatelinkResource)(nil)
+var _ resource.ResourceWithImportState = (*privatelinkResource)(nil)
+
+func NewPrivatelinkResource() resource.Resource {
+	return &privatelinkResource{}
+}
+
+type privatelinkResource struct {
+	client *client.GalaxyClient
+}
+
+type privatelinkModel struct {
+	PrivatelinkId       types.String `tfsdk:"privatelink_id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	CloudRegionId       types.String `tfsdk:"cloud_region_id"`
+	AllowedPrincipals   types.List   `tfsdk:"allowed_principals"`
+	EndpointServiceName types.String `tfsdk:"endpoint_service_name"`
+	Status              types.String `tfsdk:"status"`
+}
+
+func (r *privatelinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privatelink"
+}
+
+func (r *privatelinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a private link between Galaxy and your cloud account. Creation waits until Galaxy has provisioned the link; if status is PENDING_ACCEPTANCE, approve the connection for endpoint_service_name on the AWS side before catalogs or clusters can use it.",
+		Attributes: map[string]schema.Attribute{
+			"privatelink_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Private link ID (read only)",
+				// privatelink_id is assigned at creation and never changes. Without UseStateForUnknown,
+				// any update marks it "known after apply", which propagates to catalogs referencing it
+				// through private_link_id and forces needless catalog updates.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Private link name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Private link description",
+			},
+			"cloud_region_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Cloud region ID the private link is created in",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_principals": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Cloud principals (for example AWS account or IAM role ARNs) allowed to connect to the private link endpoint service",
+			},
+			"endpoint_service_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Endpoint service name whose connection request must be approved on the AWS side (read only)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Private link status (read only)",
+			},
+		},
+	}
+}
+
+func (r *privatelinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *privatelinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan privatelinkModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.modelToCreateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating privatelink", map[string]interface{}{"name": plan.Name.ValueString()})
+	response, err := r.client.CreatePrivatelink(ctx, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating privatelink", "Could not create privatelink", err)
+		return
+	}
+
+	privatelinkID, ok := response["privatelinkId"].(string)
+	if !ok || privatelinkID == "" {
+		resp.Diagnostics.AddError(
+			"Error creating privatelink",
+			"Create response missing privatelinkId; cannot poll for readiness.",
+		)
+		return
+	}
+
+	// Save the ID to state before polling so a polling failure leaves a recoverable resource
+	// rather than an orphaned private link. Only privatelink_id is set - the rest of the plan
+	// still has unknown computed values that would fail Terraform's consistency check.
+	plan.PrivatelinkId = types.StringValue(privatelinkID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("privatelink_id"), privatelinkID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Catalogs and clusters that reference this private link are rejected by the API until the
+	// link has been provisioned, and Terraform only waits for Create to return.
+	response, err = r.waitForPrivatelinkUsable(ctx, privatelinkID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for privatelink to become usable",
+			err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Status.ValueString() == "PENDING_ACCEPTANCE" {
+		resp.Diagnostics.AddWarning(
+			"Private link connection awaiting approval",
+			fmt.Sprintf("Private link %s is waiting for its connection to endpoint service %s to be accepted on the AWS side.", privatelinkID, plan.EndpointServiceName.ValueString()),
+		)
+	}
+
+	tflog.Debug(ctx, "Created privatelink", map[string]interface{}{"id": plan.PrivatelinkId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+const (
+	privatelinkReadyPollInterval = 10 * time.Second
+	// Endpoint service provisioning is an AWS-side operation that usually completes in a few
+	// minutes; 20 minutes leaves room for AWS API throttling.
+	privatelinkReadyTimeout = 20 * time.Minute
+)
+
+// privatelinkUsableStates lists states in which the private link has an endpoint service and
+// can be referenced. PENDING_ACCEPTANCE is included because acceptance happens outside Galaxy
+// (often in the same Terraform run via the AWS provider), so waiting for ACTIVE would deadlock.
+var privatelinkUsableStates = map[string]struct{}{
+	"AVAILABLE":          {},
+	"ACTIVE":             {},
+	"PENDING_ACCEPTANCE": {},
+}
+
+// privatelinkTerminalFailureStates lists private link states that cannot become usable.
+var privatelinkTerminalFailureStates = map[string]struct{}{
+	"FAILED":   {},
+	"REJECTED": {},
+	"DELETING": {},
+	"DELETED":  {},
+}
+
+// waitForPrivatelinkUsable polls the private link until it reaches a usable state, returning
+// early if it enters a terminal failure state.
+func (r *privatelinkResource) waitForPrivatelinkUsable(ctx context.Context, privatelinkID string) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, privatelinkReadyTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(privatelinkReadyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		response, err := r.client.GetPrivatelink(ctx, privatelinkID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get privatelink %s: %w", privatelinkID, err)
+		}
+
+		status, _ := response["status"].(string)
+		if _, usable := privatelinkUsableStates[status]; usable {
+			return response, nil
+		}
+		if _, terminal := privatelinkTerminalFailureStates[status]; terminal {
+			return nil, fmt.Errorf("privatelink %s entered terminal state %s and cannot become usable", privatelinkID, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("privatelink %s did not become usable before timeout (last status: %s)", privatelinkID, status)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *privatelinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state privatelinkModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.PrivatelinkId.ValueString()
+	tflog.Debug(ctx, "Reading privatelink", map[string]interface{}{"id": id})
+	response, err := r.client.GetPrivatelink(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Privatelink not found, removing from state", map[string]interface{}{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading privatelink",
+			"Could not read privatelink "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &state, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *privatelinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan privatelinkModel
+	var state privatelinkModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.PrivatelinkId.ValueString()
+	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating privatelink", map[string]interface{}{"id": id})
+	response, err := r.client.UpdatePrivatelink(ctx, id, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating privatelink", "Could not update privatelink "+id, err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updated privatelink", map[string]interface{}{"id": plan.PrivatelinkId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *privatelinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state privatelinkModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.PrivatelinkId.ValueString()
+	tflog.Debug(ctx, "Deleting privatelink", map[string]interface{}{"id": id})
+	err := r.client.DeletePrivatelink(ctx, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting privatelink",
+				"Could not delete privatelink "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted privatelink", map[string]interface{}{"id": id})
+}
+
+func (r *privatelinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("privatelink_id"), req, resp)
+}
+
+// Helper methods
+func (r *privatelinkResource) modelToCreateRequest(ctx context.Context, model *privatelinkModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	// Required fields
+	request["name"] = model.Name.ValueString()
+	request["cloudRegionId"] = model.CloudRegionId.ValueString()
+
+	// Optional fields
+	if !model.Description.IsNull() && !model.Description.IsUnknown() && model.Description.ValueString() != "" {
+		request["description"] = model.Description.ValueString()
+	}
+
+	principals, d := stringListElements(ctx, model.AllowedPrincipals)
+	diags.Append(d...)
+	if principals != nil {
+		request["allowedPrincipals"] = principals
+	}
+
+	return request
+}
+
+func (r *privatelinkResource) modelToUpdateRequest(ctx context.Context, model *privatelinkModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := r.modelToCreateRequest(ctx, model, diags)
+
+	// cloudRegionId is immutable (RequiresReplace) and rejected by the PATCH endpoint.
+	delete(request, "cloudRegionId")
+
+	// Send an explicit empty list when principals are removed so the PATCH merge clears them
+	// instead of preserving the previous allow-list.
+	if _, ok := request["allowedPrincipals"]; !ok {
+		request["allowedPrincipals"] = []string{}
+	}
+
+	return request
+}
+
+func (r *privatelinkResource) updateModelFromResponse(ctx context.Context, model *privatelinkModel, response map[string]interface{}, diags *diag.Diagnostics) {
+	if id, ok := response["privatelinkId"].(string); ok {
+		model.PrivatelinkId = types.StringValue(id)
+	}
+
+	if name, ok := response["name"].(string); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if description, ok := response["description"].(string); ok {
+		model.Description = types.StringValue(description)
+	} else {
+		model.Description = types.StringNull()
+	}
+
+	if cloudRegionId, ok := response["cloudRegionId"].(string); ok {
+		model.CloudRegionId = types.StringValue(cloudRegionId)
+	}
+
+	// allowed_principals is Optional without Computed, so state must match the configuration.
+	// Only take the API value when the user set the attribute, reordered to match the plan
+	// because the API does not preserve submission order.
+	if principalsRaw, ok := response["allowedPrincipals"].([]interface{}); ok && !model.AllowedPrincipals.IsNull() && !model.AllowedPrincipals.IsUnknown() {
+		planned, d := stringListElements(ctx, model.AllowedPrincipals)
+		diags.Append(d...)
+
+		principals := make([]string, 0, len(principalsRaw))
+		for _, p := range principalsRaw {
+			if s, ok := p.(string); ok {
+				principals = append(principals, s)
+			}
+		}
+		principals = reorderToMatchPlan(planned, principals)
+
+		listValue, d := types.ListValueFrom(ctx, types.StringType, principals)
+		diags.Append(d...)
+		if !d.HasError() {
+			model.AllowedPrincipals = listValue
+		}
+	}
+
+	if endpointServiceName, ok := response["endpointServiceName"].(string); ok && endpointServiceName != "" {
+		model.EndpointServiceName = types.StringValue(endpointServiceName)
+	} else if model.EndpointServiceName.IsUnknown() {
+		model.EndpointServiceName = types.StringNull()
+	}
+
+	if status, ok := response["status"].(string); ok {
+		model.Status = types.StringValue(status)
+	} else {
+		model.Status = types.StringNull()
+	}
+}
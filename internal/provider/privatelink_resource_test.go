@@ -0,0 +1,33 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPrivatelinkModelToUpdateRequest(t *testing.T) {
+	r := &privatelinkResource{}
+	model := &privatelinkModel{
+		Name:              types.StringValue("test"),
+		CloudRegionId:     types.StringValue("aws-us-east1"),
+		AllowedPrincipals: types.ListNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	request := r.modelToUpdateRequest(context.Background(), model, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if _, ok := request["cloudRegionId"]; ok {
+		t.Errorf("expected cloudRegionId to be omitted from update request, got: %v", request["cloudRegionId"])
+	}
+	principals, ok := request["allowedPrincipals"].([]string)
+	if !ok || len(principals) != 0 {
+		t.Errorf("expected removed principals to be sent as an empty list, got: %v", request["allowedPrincipals"])
+	}
+}
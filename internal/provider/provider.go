@@ -11,12 +11,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -24,6 +34,7 @@ import (
 )
 
 var _ provider.Provider = (*galaxyProvider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*galaxyProvider)(nil)
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -38,9 +49,28 @@ type galaxyProvider struct {
 }
 
 type galaxyProviderModel struct {
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	Domain       types.String `tfsdk:"domain"`
+	ClientID      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+	Domain        types.String `tfsdk:"domain"`
+	Profile       types.String `tfsdk:"profile"`
+	AccessToken   types.String `tfsdk:"access_token"`
+	OIDCTokenFile types.String `tfsdk:"oidc_token_file"`
+
+	MaxRetries                types.Int64   `tfsdk:"max_retries"`
+	RequestTimeout            types.String  `tfsdk:"request_timeout"`
+	RateLimitPerSecond        types.Float64 `tfsdk:"rate_limit_per_second"`
+	ClusterRateLimitPerSecond types.Float64 `tfsdk:"cluster_rate_limit_per_second"`
+
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+
+	HTTPTrace types.Bool `tfsdk:"http_trace"`
 }
 
 func (p *galaxyProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -60,6 +90,94 @@ func (p *galaxyProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:    true,
 				Description: "Galaxy Domain URL. Can also be set via GALAXY_DOMAIN environment variable.",
 			},
+			"profile": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of a profile in ~/.galaxy/credentials to read client_id, client_secret and domain from. The file holds one [profile] or YAML-style \"profile:\" header or key/value setting per line; settings the provider does not use produce a warning. " +
+					"Provider attributes take precedence over the profile, and the profile over GALAXY_CLIENT_ID, GALAXY_CLIENT_SECRET and GALAXY_DOMAIN. " +
+					"Without a profile, the default profile fills in settings that are set nowhere else. " +
+					"Can also be set via GALAXY_PROFILE environment variable; the file location via GALAXY_CREDENTIALS_FILE.",
+			},
+			"access_token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Pre-issued Galaxy access token, e.g. minted by a CI pipeline, used instead of client_id and client_secret. " +
+					"The token is not renewed; runs fail once it expires. Can also be set via GALAXY_ACCESS_TOKEN environment variable.",
+			},
+			"oidc_token_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to an OIDC ID token, e.g. from GitHub Actions or GitLab CI workload identity, that is exchanged for a Galaxy access token. " +
+					"Requires client_id of the Galaxy client that trusts the token issuer; client_secret is not used. " +
+					"The file is re-read whenever the access token is refreshed. Can also be set via GALAXY_OIDC_TOKEN_FILE environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of times a failed API request is retried. Defaults to 5; 0 disables retries. Can also be set via GALAXY_MAX_RETRIES environment variable.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout of each API request as a Go duration, e.g. \"90s\" or \"2m\". Defaults to 30s. Can also be set via GALAXY_REQUEST_TIMEOUT environment variable.",
+			},
+			"rate_limit_per_second": schema.Float64Attribute{
+				Optional: true,
+				Description: "Maximum steady-state rate of API requests per second. Defaults to 1.5. The budget is shared by every provider instance in the Terraform process; " +
+					"when instances configure different rates, the lowest one applies. Can also be set via GALAXY_RATE_LIMIT_PER_SECOND environment variable.",
+			},
+			"cluster_rate_limit_per_second": schema.Float64Attribute{
+				Optional: true,
+				Description: "Maximum steady-state rate of cluster API requests per second, applied on top of rate_limit_per_second. Defaults to 0.3. Shared like rate_limit_per_second. " +
+					"Can also be set via GALAXY_CLUSTER_RATE_LIMIT_PER_SECOND environment variable.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				Description: "URL of an HTTP, HTTPS or SOCKS5 proxy for all requests to Galaxy, e.g. \"http://proxy.example.com:3128\". " +
+					"Defaults to the HTTPS_PROXY and NO_PROXY environment variables. Can also be set via GALAXY_PROXY_URL environment variable.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to a PEM bundle of CA certificates trusted in addition to the system roots, e.g. the root of a TLS-intercepting proxy. " +
+					"Can also be set via GALAXY_CA_CERT_FILE environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM bundle of CA certificates trusted in addition to the system roots. Conflicts with ca_cert_file.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional: true,
+				Description: "Disable verification of the Galaxy TLS certificate. This exposes credentials and data to anyone able to intercept the connection; " +
+					"prefer ca_cert_file. Can also be set via GALAXY_INSECURE_SKIP_VERIFY environment variable.",
+			},
+			"client_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM client certificate presented for mutual TLS. Requires a client key. Can also be set via GALAXY_CLIENT_CERT_FILE environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the PEM private key of client_cert_file. Can also be set via GALAXY_CLIENT_KEY_FILE environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM client certificate presented for mutual TLS. Requires a client key.",
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM private key of the client certificate.",
+			},
+			"http_trace": schema.BoolAttribute{
+				Optional: true,
+				Description: "Log every Galaxy API request at INFO level with its method, path, status, latency, retry attempt and bodies, for debugging failed applies. " +
+					"Values of sensitive fields such as passwords, secret keys, private keys, credential keys and tokens are masked. " +
+					"Can also be set via GALAXY_HTTP_TRACE environment variable.",
+			},
 		},
 	}
 }
@@ -88,47 +206,36 @@ func (p *galaxyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		domain = config.Domain.ValueString()
 	}
 
-	// Validate required configuration
-	if clientID == "" {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"The provider cannot create the Galaxy client as there is a missing or empty value for the Galaxy client ID. "+
-				"Set the client_id value in the configuration or use the GALAXY_CLIENT_ID environment variable.",
-		)
-	}
+	applyProfile(configString(config.Profile, "GALAXY_PROFILE"), config, &clientID, &clientSecret, &domain, &resp.Diagnostics)
 
-	if clientSecret == "" {
-		resp.Diagnostics.AddError(
-			"Missing Client Secret",
-			"The provider cannot create the Galaxy client as there is a missing or empty value for the Galaxy client secret. "+
-				"Set the client_secret value in the configuration or use the GALAXY_CLIENT_SECRET environment variable.",
-		)
-	}
+	accessToken := configString(config.AccessToken, "GALAXY_ACCESS_TOKEN")
+	oidcTokenFile := configString(config.OIDCTokenFile, "GALAXY_OIDC_TOKEN_FILE")
 
-	if domain == "" {
-		resp.Diagnostics.AddError(
-			"Missing Domain",
-			"The provider cannot create the Galaxy client as there is a missing or empty value for the Galaxy domain. "+
-				"Set the domain value in the configuration or use the GALAXY_DOMAIN environment variable.",
-		)
-	}
+	// Validate required configuration
+	authMode := validateCredentials(clientID, clientSecret, domain, accessToken, oidcTokenFile, &resp.Diagnostics)
+
+	opts := clientOptions(config, &resp.Diagnostics)
+	opts.AccessToken = accessToken
+	opts.OIDCTokenFile = oidcTokenFile
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create the Galaxy client
-	client := client.NewGalaxyClient(domain, clientID, clientSecret, p.version)
+	client := client.NewGalaxyClient(domain, clientID, clientSecret, p.version, opts)
 
 	// Log the successful configuration
 	tflog.Info(ctx, "Configured Galaxy client", map[string]interface{}{
-		"domain":  domain,
-		"version": p.version,
+		"domain":    domain,
+		"version":   p.version,
+		"auth_mode": authMode,
 	})
 
 	// Store the client in the provider data for use by resources and data sources
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *galaxyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -158,6 +265,10 @@ func (p *galaxyProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewRolegrantDataSource,
 		NewSchemaDataSource,
 		NewColumnDataSource,
+		NewSshTunnelDataSource,
+		NewSqlJobHistoryDataSource,
+		NewFileIngestSourceDataSource,
+		NewKafkaIngestSourceDataSource,
 
 		// List data sources
 		NewClustersDataSource,
@@ -171,11 +282,16 @@ func (p *galaxyProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewTagsDataSource,
 		NewCatalogsDataSource,
 		NewCrossAccountIamRolesDataSource,
+		NewCloudRegionsDataSource,
 
 		// New list data sources from OpenAPI changes - implemented
 		NewPrivatelinksDataSource,
 		// Newly implemented list data source
 		NewDataQualitySummariesDataSource,
+		NewSshTunnelsDataSource,
+		NewSqlJobsDataSource,
+		NewFileIngestSourcesDataSource,
+		NewKafkaIngestSourcesDataSource,
 		NewRolePrivilegeGrantDataSource,
 		NewGroupsDataSource,
 		NewUsageExampleDataSource,
@@ -227,6 +343,8 @@ func (p *galaxyProvider) Resources(ctx context.Context) []func() resource.Resour
 	return []func() resource.Resource{
 		// Core resources
 		NewClusterResource,
+		NewClusterScheduleResource,
+		NewClusterCatalogAttachmentResource,
 		NewRoleResource,
 		NewServiceAccountResource,
 		NewServiceAccountPasswordResource,
@@ -243,6 +361,17 @@ func (p *galaxyProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewTagResource,
 		NewCrossAccountIamRoleResource,
 		NewDataQualityCheckResource,
+		NewSshTunnelResource,
+		NewPrivatelinkResource,
+		NewSqlJobResource,
+		NewUserResource,
+		NewDataQualityScheduleResource,
+		NewFileIngestSourceResource,
+		NewKafkaIngestSourceResource,
+		NewIngestStreamResource,
+		NewUsageExampleResource,
+		NewTagAssignmentResource,
+		NewEntityTagsResource,
 
 		// Catalog resources
 		NewS3CatalogResource,
@@ -258,3 +387,325 @@ func (p *galaxyProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewSnowflakeCatalogResource,
 	}
 }
+
+func (p *galaxyProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewServiceAccountPasswordEphemeralResource,
+	}
+}
+
+// Authentication modes, in order of precedence.
+const (
+	authModeAccessToken       = "access_token"
+	authModeOIDC              = "oidc"
+	authModeClientCredentials = "client_credentials"
+)
+
+// credentialPrecedence explains where client_id, client_secret and domain are read from.
+const credentialPrecedence = "Settings are taken from provider attributes first, then from the profile selected with profile or GALAXY_PROFILE, " +
+	"then from the GALAXY_CLIENT_ID, GALAXY_CLIENT_SECRET and GALAXY_DOMAIN environment variables, and finally from the default profile of ~/.galaxy/credentials."
+
+// applyProfile fills in client_id, client_secret and domain from the credentials file,
+// following credentialPrecedence. clientID, clientSecret and domain hold the values resolved
+// from attributes and environment variables. A selected profile must exist; the default
+// profile is only consulted for settings that are still unset.
+func applyProfile(name string, config galaxyProviderModel, clientID, clientSecret, domain *string, diags *diag.Diagnostics) {
+	explicit := name != ""
+	if !explicit {
+		if *clientID != "" && *clientSecret != "" && *domain != "" {
+			return
+		}
+		name = defaultProfile
+	}
+
+	profile, file, found, err := loadProfile(name)
+	if err != nil {
+		if explicit {
+			diags.AddAttributeError(path.Root("profile"), "Invalid Credentials File", "Could not read profile "+name+": "+err.Error())
+		} else {
+			diags.AddWarning("Invalid Credentials File", "Ignoring the default profile: "+err.Error())
+		}
+		return
+	}
+	if !found {
+		if explicit {
+			diags.AddAttributeError(
+				path.Root("profile"),
+				"Profile Not Found",
+				fmt.Sprintf("Profile %q was not found in %s. Add it to the file or set GALAXY_CREDENTIALS_FILE to the file that defines it.", name, file),
+			)
+		}
+		return
+	}
+	if len(profile.UnknownKeys) > 0 {
+		diags.AddWarning(
+			"Unknown Credentials File Settings",
+			fmt.Sprintf("Profile %q in %s sets %s, which the provider ignores. Only client_id, client_secret and domain are read.", name, file, strings.Join(profile.UnknownKeys, ", ")),
+		)
+	}
+
+	settings := []struct {
+		attribute    string
+		envVar       string
+		configured   bool
+		value        *string
+		profileValue string
+	}{
+		{"client_id", "GALAXY_CLIENT_ID", !config.ClientID.IsNull(), clientID, profile.ClientID},
+		{"client_secret", "GALAXY_CLIENT_SECRET", !config.ClientSecret.IsNull(), clientSecret, profile.ClientSecret},
+		{"domain", "GALAXY_DOMAIN", !config.Domain.IsNull(), domain, profile.Domain},
+	}
+	for _, setting := range settings {
+		if setting.configured || setting.profileValue == "" {
+			continue
+		}
+		if !explicit {
+			if *setting.value == "" {
+				*setting.value = setting.profileValue
+			}
+			continue
+		}
+		if *setting.value != "" && *setting.value != setting.profileValue {
+			diags.AddWarning(
+				"Environment Variable Ignored",
+				fmt.Sprintf("%s is ignored because profile %q sets %s. %s", setting.envVar, name, setting.attribute, credentialPrecedence),
+			)
+		}
+		*setting.value = setting.profileValue
+	}
+}
+
+// validateCredentials checks that the settings required by the selected authentication mode
+// are present and returns the mode. A pre-issued access token needs only the domain, OIDC
+// token exchange also needs the client ID, and client_credentials needs the client secret too.
+func validateCredentials(clientID, clientSecret, domain, accessToken, oidcTokenFile string, diags *diag.Diagnostics) string {
+	mode := authModeClientCredentials
+	switch {
+	case accessToken != "" && oidcTokenFile != "":
+		diags.AddError(
+			"Conflicting Authentication Settings",
+			"The provider cannot create the Galaxy client as both an access token and an OIDC token file are configured. "+
+				"Set only one of access_token (GALAXY_ACCESS_TOKEN) and oidc_token_file (GALAXY_OIDC_TOKEN_FILE).",
+		)
+		return mode
+	case accessToken != "":
+		mode = authModeAccessToken
+	case oidcTokenFile != "":
+		mode = authModeOIDC
+	}
+
+	if clientID == "" && mode != authModeAccessToken {
+		diags.AddError(
+			"Missing Client ID",
+			"The provider cannot create the Galaxy client as there is a missing or empty value for the Galaxy client ID. "+
+				"Set the client_id value in the configuration, use the GALAXY_CLIENT_ID environment variable or select a profile. "+credentialPrecedence,
+		)
+	}
+
+	if clientSecret == "" && mode == authModeClientCredentials {
+		diags.AddError(
+			"Missing Client Secret",
+			"The provider cannot create the Galaxy client as there is a missing or empty value for the Galaxy client secret. "+
+				"Set the client_secret value in the configuration, use the GALAXY_CLIENT_SECRET environment variable or select a profile, "+
+				"or authenticate with access_token or oidc_token_file. "+credentialPrecedence,
+		)
+	}
+
+	if domain == "" {
+		diags.AddError(
+			"Missing Domain",
+			"The provider cannot create the Galaxy client as there is a missing or empty value for the Galaxy domain. "+
+				"Set the domain value in the configuration, use the GALAXY_DOMAIN environment variable or select a profile. "+credentialPrecedence,
+		)
+	}
+
+	return mode
+}
+
+// clientOptions resolves the client tuning settings from the provider configuration, falling
+// back to environment variables. Unset values are left zero so the client defaults apply.
+func clientOptions(config galaxyProviderModel, diags *diag.Diagnostics) client.Options {
+	var opts client.Options
+
+	maxRetries, ok := configInt64(config.MaxRetries, "max_retries", "GALAXY_MAX_RETRIES", diags)
+	if ok {
+		if maxRetries < 0 {
+			diags.AddAttributeError(path.Root("max_retries"), "Invalid max_retries", "max_retries must be 0 or greater.")
+		} else if maxRetries == 0 {
+			opts.MaxRetries = -1
+		} else {
+			opts.MaxRetries = int(maxRetries)
+		}
+	}
+
+	timeout := os.Getenv("GALAXY_REQUEST_TIMEOUT")
+	if !config.RequestTimeout.IsNull() {
+		timeout = config.RequestTimeout.ValueString()
+	}
+	if timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil || d <= 0 {
+			diags.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid request_timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as \"90s\" or \"2m\", got %q.", timeout),
+			)
+		}
+		opts.RequestTimeout = d
+	}
+
+	if v, ok := configFloat64(config.RateLimitPerSecond, "rate_limit_per_second", "GALAXY_RATE_LIMIT_PER_SECOND", diags); ok {
+		opts.RateLimitPerSecond = v
+	}
+	if v, ok := configFloat64(config.ClusterRateLimitPerSecond, "cluster_rate_limit_per_second", "GALAXY_CLUSTER_RATE_LIMIT_PER_SECOND", diags); ok {
+		opts.ClusterRateLimitPerSecond = v
+	}
+
+	opts.HTTPTrace = configBool(config.HTTPTrace, "http_trace", "GALAXY_HTTP_TRACE", diags)
+
+	if transport := clientTransport(config, diags); transport != nil {
+		opts.Transport = transport
+	}
+
+	return opts
+}
+
+// clientTransport builds the HTTP transport from the proxy and TLS settings. It returns nil
+// when none are set, so the client keeps the default transport.
+func clientTransport(config galaxyProviderModel, diags *diag.Diagnostics) *http.Transport {
+	var topts client.TransportOptions
+	configured := false
+
+	if proxyURL := configString(config.ProxyURL, "GALAXY_PROXY_URL"); proxyURL != "" {
+		topts.ProxyURL = proxyURL
+		configured = true
+	}
+
+	if ca := configPEM(config.CACertPEM, config.CACertFile, "ca_cert_file", "GALAXY_CA_CERT_FILE", diags); ca != nil {
+		topts.CACertPEM = ca
+		configured = true
+	}
+
+	if configBool(config.InsecureSkipVerify, "insecure_skip_verify", "GALAXY_INSECURE_SKIP_VERIFY", diags) {
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS certificate verification is disabled",
+			"insecure_skip_verify is enabled, so the provider does not verify the identity of the Galaxy server. "+
+				"Client credentials, access tokens and catalog secrets can be intercepted by anyone on the network path. "+
+				"Trust the proxy's CA with ca_cert_file instead, and only use this setting for short-lived troubleshooting.",
+		)
+		topts.InsecureSkipVerify = true
+		configured = true
+	}
+
+	cert := configPEM(config.ClientCertPEM, config.ClientCertFile, "client_cert_file", "GALAXY_CLIENT_CERT_FILE", diags)
+	key := configPEM(config.ClientKeyPEM, config.ClientKeyFile, "client_key_file", "GALAXY_CLIENT_KEY_FILE", diags)
+	if (cert == nil) != (key == nil) {
+		diags.AddError("Incomplete client certificate", "A client certificate and its private key must be configured together.")
+	}
+	if cert != nil && key != nil {
+		topts.ClientCertPEM = cert
+		topts.ClientKeyPEM = key
+		configured = true
+	}
+
+	if !configured || diags.HasError() {
+		return nil
+	}
+
+	transport, err := client.NewTransport(topts)
+	if err != nil {
+		diags.AddError("Invalid TLS or proxy configuration", "The provider cannot create the Galaxy client: "+err.Error())
+		return nil
+	}
+	return transport
+}
+
+// configBool returns the configured value of a boolean provider attribute, falling back to
+// its environment variable. Unset values are false.
+func configBool(value types.Bool, attribute, envVar string, diags *diag.Diagnostics) bool {
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+	env := os.Getenv(envVar)
+	if env == "" {
+		return false
+	}
+	v, err := strconv.ParseBool(env)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), "Invalid "+attribute, fmt.Sprintf("%s must be true or false, got %q.", envVar, env))
+		return false
+	}
+	return v
+}
+
+// configString returns the configured value of a string provider attribute, falling back to
+// its environment variable.
+func configString(value types.String, envVar string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
+// configPEM returns PEM data set inline, or read from the file named by the file attribute or
+// its environment variable. It returns nil when neither is set.
+func configPEM(pem, file types.String, fileAttribute, fileEnvVar string, diags *diag.Diagnostics) []byte {
+	if !pem.IsNull() && pem.ValueString() != "" {
+		return []byte(pem.ValueString())
+	}
+
+	filePath := configString(file, fileEnvVar)
+	if filePath == "" {
+		return nil
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		diags.AddAttributeError(path.Root(fileAttribute), "Invalid "+fileAttribute, fmt.Sprintf("Could not read %s: %s", filePath, err))
+		return nil
+	}
+	return data
+}
+
+// configInt64 returns the configured value of an integer provider attribute or its environment
+// variable, and whether either was set.
+func configInt64(value types.Int64, attribute, envVar string, diags *diag.Diagnostics) (int64, bool) {
+	if !value.IsNull() {
+		return value.ValueInt64(), true
+	}
+	env := os.Getenv(envVar)
+	if env == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), "Invalid "+attribute, fmt.Sprintf("%s must be an integer, got %q.", envVar, env))
+		return 0, false
+	}
+	return v, true
+}
+
+// configFloat64 returns the configured value of a positive rate provider attribute or its
+// environment variable, and whether either was set.
+func configFloat64(value types.Float64, attribute, envVar string, diags *diag.Diagnostics) (float64, bool) {
+	var v float64
+	switch {
+	case !value.IsNull():
+		v = value.ValueFloat64()
+	case os.Getenv(envVar) != "":
+		var err error
+		v, err = strconv.ParseFloat(os.Getenv(envVar), 64)
+		if err != nil {
+			diags.AddAttributeError(path.Root(attribute), "Invalid "+attribute, fmt.Sprintf("%s must be a number, got %q.", envVar, os.Getenv(envVar)))
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if v <= 0 {
+		diags.AddAttributeError(path.Root(attribute), "Invalid "+attribute, attribute+" must be greater than 0.")
+		return 0, false
+	}
+	return v, true
+}
@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -57,3 +60,100 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("GALAXY_DOMAIN must be set for acceptance tests")
 	}
 }
+
+func TestClientOptions(t *testing.T) {
+	t.Setenv("GALAXY_MAX_RETRIES", "9")
+	t.Setenv("GALAXY_REQUEST_TIMEOUT", "45s")
+	t.Setenv("GALAXY_RATE_LIMIT_PER_SECOND", "4")
+	t.Setenv("GALAXY_CLUSTER_RATE_LIMIT_PER_SECOND", "")
+
+	config := galaxyProviderModel{
+		MaxRetries:                types.Int64Value(0),
+		RequestTimeout:            types.StringNull(),
+		RateLimitPerSecond:        types.Float64Null(),
+		ClusterRateLimitPerSecond: types.Float64Value(1),
+	}
+
+	var diags diag.Diagnostics
+	opts := clientOptions(config, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if opts.MaxRetries != -1 {
+		t.Errorf("max_retries = 0 should disable retries, got %d", opts.MaxRetries)
+	}
+	if opts.RequestTimeout != 45*time.Second {
+		t.Errorf("expected request timeout from environment, got %v", opts.RequestTimeout)
+	}
+	if opts.RateLimitPerSecond != 4 || opts.ClusterRateLimitPerSecond != 1 {
+		t.Errorf("unexpected rate limits: %v, %v", opts.RateLimitPerSecond, opts.ClusterRateLimitPerSecond)
+	}
+
+	t.Setenv("GALAXY_REQUEST_TIMEOUT", "soon")
+	t.Setenv("GALAXY_RATE_LIMIT_PER_SECOND", "-1")
+	diags = nil
+	clientOptions(galaxyProviderModel{}, &diags)
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("expected errors for request_timeout and rate_limit_per_second, got %v", diags)
+	}
+}
+
+func TestClientTransport(t *testing.T) {
+	for _, envVar := range []string{"GALAXY_PROXY_URL", "GALAXY_CA_CERT_FILE", "GALAXY_INSECURE_SKIP_VERIFY", "GALAXY_CLIENT_CERT_FILE", "GALAXY_CLIENT_KEY_FILE"} {
+		t.Setenv(envVar, "")
+	}
+
+	var diags diag.Diagnostics
+	if transport := clientTransport(galaxyProviderModel{}, &diags); transport != nil || diags.HasError() {
+		t.Fatalf("expected the default transport when nothing is set, got %v, %v", transport, diags)
+	}
+
+	t.Setenv("GALAXY_PROXY_URL", "http://proxy.example.com:3128")
+	t.Setenv("GALAXY_INSECURE_SKIP_VERIFY", "true")
+	transport := clientTransport(galaxyProviderModel{}, &diags)
+	if diags.HasError() || transport == nil {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("expected certificate verification to be disabled")
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning about insecure_skip_verify, got %v", diags)
+	}
+
+	t.Setenv("GALAXY_INSECURE_SKIP_VERIFY", "")
+	diags = nil
+	clientTransport(galaxyProviderModel{
+		CACertFile:    types.StringValue("/nonexistent/ca.pem"),
+		ClientCertPEM: types.StringValue("-----BEGIN CERTIFICATE-----"),
+	}, &diags)
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("expected errors for the unreadable CA file and the missing client key, got %v", diags)
+	}
+}
+
+func TestValidateCredentials(t *testing.T) {
+	cases := []struct {
+		name                                                       string
+		clientID, clientSecret, domain, accessToken, oidcTokenFile string
+		mode                                                       string
+		errors                                                     int
+	}{
+		{"client credentials", "id", "secret", "https://x.galaxy.starburst.io", "", "", authModeClientCredentials, 0},
+		{"missing secret", "id", "", "https://x.galaxy.starburst.io", "", "", authModeClientCredentials, 1},
+		{"access token only", "", "", "https://x.galaxy.starburst.io", "token", "", authModeAccessToken, 0},
+		{"oidc", "id", "", "https://x.galaxy.starburst.io", "", "/tmp/token", authModeOIDC, 0},
+		{"oidc without client id", "", "", "https://x.galaxy.starburst.io", "", "/tmp/token", authModeOIDC, 1},
+		{"conflicting modes", "id", "", "https://x.galaxy.starburst.io", "token", "/tmp/token", authModeClientCredentials, 1},
+		{"missing domain", "", "", "", "token", "", authModeAccessToken, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			mode := validateCredentials(tc.clientID, tc.clientSecret, tc.domain, tc.accessToken, tc.oidcTokenFile, &diags)
+			if mode != tc.mode || diags.ErrorsCount() != tc.errors {
+				t.Errorf("expected mode %s with %d errors, got %s with %v", tc.mode, tc.errors, mode, diags)
+			}
+		})
+	}
+}
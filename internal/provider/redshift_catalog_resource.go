@@ -93,27 +93,23 @@ func (r *redshift_catalogResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	// password is WriteOnly: read from req.Config.
+	// password, access_key and secret_key are WriteOnly: read from req.Config.
 	var config resource_redshift_catalog.RedshiftCatalogModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	plan.Password = config.Password
+	plan.AccessKey = config.AccessKey
+	plan.SecretKey = config.SecretKey
 
 	// Initialize unknown values to null for optional fields
-	if plan.AccessKey.IsUnknown() {
-		plan.AccessKey = types.StringNull()
-	}
 	if plan.Region.IsUnknown() {
 		plan.Region = types.StringNull()
 	}
 	if plan.RoleArn.IsUnknown() {
 		plan.RoleArn = types.StringNull()
 	}
-	if plan.SecretKey.IsUnknown() {
-		plan.SecretKey = types.StringNull()
-	}
 	if plan.SshTunnelId.IsUnknown() {
 		plan.SshTunnelId = types.StringNull()
 	}
@@ -126,10 +122,7 @@ func (r *redshift_catalogResource) Create(ctx context.Context, req resource.Crea
 	tflog.Debug(ctx, "Creating redshift_catalog")
 	response, err := r.client.CreateCatalog(ctx, "redshift", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating redshift_catalog",
-			"Could not create redshift_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating redshift_catalog", "Could not create redshift_catalog", err)
 		return
 	}
 
@@ -189,13 +182,15 @@ func (r *redshift_catalogResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	// password is WriteOnly: read from req.Config.
+	// password, access_key and secret_key are WriteOnly: read from req.Config.
 	var config resource_redshift_catalog.RedshiftCatalogModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	plan.Password = config.Password
+	plan.AccessKey = config.AccessKey
+	plan.SecretKey = config.SecretKey
 
 	id := state.CatalogId.ValueString()
 	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
@@ -206,10 +201,7 @@ func (r *redshift_catalogResource) Update(ctx context.Context, req resource.Upda
 	tflog.Debug(ctx, "Updating redshift_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "redshift", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating redshift_catalog",
-			"Could not update redshift_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating redshift_catalog", "Could not update redshift_catalog "+id, err)
 		return
 	}
 
@@ -345,13 +337,7 @@ func (r *redshift_catalogResource) updateModelFromResponse(ctx context.Context,
 		model.Username = types.StringValue(username)
 	}
 
-	// Password is write-only, keep existing value
-
-	if accessKey, ok := response["accessKey"].(string); ok && accessKey != "" {
-		model.AccessKey = types.StringValue(accessKey)
-	} else {
-		model.AccessKey = types.StringNull()
-	}
+	// Password, access key and secret key are write-only, keep existing values
 
 	if region, ok := response["region"].(string); ok && region != "" {
 		model.Region = types.StringValue(region)
@@ -365,12 +351,6 @@ func (r *redshift_catalogResource) updateModelFromResponse(ctx context.Context,
 		model.RoleArn = types.StringNull()
 	}
 
-	if secretKey, ok := response["secretKey"].(string); ok && secretKey != "" {
-		model.SecretKey = types.StringValue(secretKey)
-	} else {
-		model.SecretKey = types.StringNull()
-	}
-
 	if sshTunnelId, ok := response["sshTunnelId"].(string); ok && sshTunnelId != "" {
 		model.SshTunnelId = types.StringValue(sshTunnelId)
 	} else {
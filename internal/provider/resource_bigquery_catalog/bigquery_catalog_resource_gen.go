@@ -24,6 +24,11 @@ func BigqueryCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Base64 encoded or plain text BigQuery JSON key",
 				MarkdownDescription: "Base64 encoded or plain text BigQuery JSON key",
 			},
+			"credentials_key_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of credentials_key. Because credentials_key is write-only, Terraform cannot detect changes to it; change this value to send an updated credentials_key to Galaxy.",
+				MarkdownDescription: "Version of credentials_key. Because credentials_key is write-only, Terraform cannot detect changes to it; change this value to send an updated credentials_key to Galaxy.",
+			},
 			"description": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -63,12 +68,13 @@ func BigqueryCatalogResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type BigqueryCatalogModel struct {
-	CatalogId       types.String `tfsdk:"catalog_id"`
-	CredentialsKey  types.String `tfsdk:"credentials_key"`
-	Description     types.String `tfsdk:"description"`
-	Name            types.String `tfsdk:"name"`
-	ParentProjectId types.String `tfsdk:"parent_project_id"`
-	ProjectId       types.String `tfsdk:"project_id"`
-	ReadOnly        types.Bool   `tfsdk:"read_only"`
-	Validate        types.Bool   `tfsdk:"validate"`
+	CatalogId             types.String `tfsdk:"catalog_id"`
+	CredentialsKey        types.String `tfsdk:"credentials_key"`
+	CredentialsKeyVersion types.Int64  `tfsdk:"credentials_key_version"`
+	Description           types.String `tfsdk:"description"`
+	Name                  types.String `tfsdk:"name"`
+	ParentProjectId       types.String `tfsdk:"parent_project_id"`
+	ProjectId             types.String `tfsdk:"project_id"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
+	Validate              types.Bool   `tfsdk:"validate"`
 }
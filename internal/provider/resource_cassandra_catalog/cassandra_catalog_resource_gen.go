@@ -71,6 +71,11 @@ func CassandraCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "",
 				MarkdownDescription: "",
 			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+				MarkdownDescription: "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+			},
 			"port": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
@@ -101,6 +106,11 @@ func CassandraCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "",
 				MarkdownDescription: "",
 			},
+			"token_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of token. Because token is write-only, Terraform cannot detect changes to it; change this value to send an updated token to Galaxy.",
+				MarkdownDescription: "Version of token. Because token is write-only, Terraform cannot detect changes to it; change this value to send an updated token to Galaxy.",
+			},
 			"username": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -127,11 +137,13 @@ type CassandraCatalogModel struct {
 	LocalDatacenter types.String `tfsdk:"local_datacenter"`
 	Name            types.String `tfsdk:"name"`
 	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
 	Port            types.Int64  `tfsdk:"port"`
 	ReadOnly        types.Bool   `tfsdk:"read_only"`
 	Region          types.String `tfsdk:"region"`
 	SshTunnelId     types.String `tfsdk:"ssh_tunnel_id"`
 	Token           types.String `tfsdk:"token"`
+	TokenVersion    types.Int64  `tfsdk:"token_version"`
 	Username        types.String `tfsdk:"username"`
 	Validate        types.Bool   `tfsdk:"validate"`
 }
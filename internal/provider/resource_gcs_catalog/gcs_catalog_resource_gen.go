@@ -24,6 +24,11 @@ func GcsCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "",
 				MarkdownDescription: "",
 			},
+			"credentials_key_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of credentials_key. Because credentials_key is write-only, Terraform cannot detect changes to it; change this value to send an updated credentials_key to Galaxy.",
+				MarkdownDescription: "Version of credentials_key. Because credentials_key is write-only, Terraform cannot detect changes to it; change this value to send an updated credentials_key to Galaxy.",
+			},
 			"default_bucket": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -104,6 +109,7 @@ func GcsCatalogResourceSchema(ctx context.Context) schema.Schema {
 type GcsCatalogModel struct {
 	CatalogId                    types.String `tfsdk:"catalog_id"`
 	CredentialsKey               types.String `tfsdk:"credentials_key"`
+	CredentialsKeyVersion        types.Int64  `tfsdk:"credentials_key_version"`
 	DefaultBucket                types.String `tfsdk:"default_bucket"`
 	DefaultDataLocation          types.String `tfsdk:"default_data_location"`
 	DefaultTableFormat           types.String `tfsdk:"default_table_format"`
@@ -71,6 +71,11 @@ func MongodbCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "MongoDB password",
 				MarkdownDescription: "MongoDB password",
 			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+				MarkdownDescription: "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+			},
 			"private_link_id": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -125,6 +130,7 @@ type MongodbCatalogModel struct {
 	Hosts                    types.String `tfsdk:"hosts"`
 	Name                     types.String `tfsdk:"name"`
 	Password                 types.String `tfsdk:"password"`
+	PasswordVersion          types.Int64  `tfsdk:"password_version"`
 	PrivateLinkId            types.String `tfsdk:"private_link_id"`
 	ReadOnly                 types.Bool   `tfsdk:"read_only"`
 	Regions                  types.List   `tfsdk:"regions"`
@@ -61,6 +61,11 @@ func PostgresqlCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "",
 				MarkdownDescription: "",
 			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+				MarkdownDescription: "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+			},
 			"port": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
@@ -106,18 +111,19 @@ func PostgresqlCatalogResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type PostgresqlCatalogModel struct {
-	CatalogId     types.String `tfsdk:"catalog_id"`
-	CloudKind     types.String `tfsdk:"cloud_kind"`
-	DatabaseName  types.String `tfsdk:"database_name"`
-	Description   types.String `tfsdk:"description"`
-	Endpoint      types.String `tfsdk:"endpoint"`
-	Name          types.String `tfsdk:"name"`
-	Password      types.String `tfsdk:"password"`
-	Port          types.Int64  `tfsdk:"port"`
-	PrivateLinkId types.String `tfsdk:"private_link_id"`
-	ReadOnly      types.Bool   `tfsdk:"read_only"`
-	SshTunnelId   types.String `tfsdk:"ssh_tunnel_id"`
-	TlsEnabled    types.Bool   `tfsdk:"tls_enabled"`
-	Username      types.String `tfsdk:"username"`
-	Validate      types.Bool   `tfsdk:"validate"`
+	CatalogId       types.String `tfsdk:"catalog_id"`
+	CloudKind       types.String `tfsdk:"cloud_kind"`
+	DatabaseName    types.String `tfsdk:"database_name"`
+	Description     types.String `tfsdk:"description"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Name            types.String `tfsdk:"name"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	Port            types.Int64  `tfsdk:"port"`
+	PrivateLinkId   types.String `tfsdk:"private_link_id"`
+	ReadOnly        types.Bool   `tfsdk:"read_only"`
+	SshTunnelId     types.String `tfsdk:"ssh_tunnel_id"`
+	TlsEnabled      types.Bool   `tfsdk:"tls_enabled"`
+	Username        types.String `tfsdk:"username"`
+	Validate        types.Bool   `tfsdk:"validate"`
 }
@@ -14,9 +14,15 @@ func RedshiftCatalogResourceSchema(ctx context.Context) schema.Schema {
 		Attributes: map[string]schema.Attribute{
 			"access_key": schema.StringAttribute{
 				Optional:            true,
-				Computed:            true,
-				Description:         "",
-				MarkdownDescription: "",
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "AWS access key",
+				MarkdownDescription: "AWS access key",
+			},
+			"access_key_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of access_key. Because access_key is write-only, Terraform cannot detect changes to it; change this value to send an updated access_key to Galaxy.",
+				MarkdownDescription: "Version of access_key. Because access_key is write-only, Terraform cannot detect changes to it; change this value to send an updated access_key to Galaxy.",
 			},
 			"auth_type": schema.StringAttribute{
 				Required: true,
@@ -49,6 +55,11 @@ func RedshiftCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "",
 				MarkdownDescription: "",
 			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+				MarkdownDescription: "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+			},
 			"read_only": schema.BoolAttribute{
 				Required:            true,
 				Description:         "Is catalog read only",
@@ -68,9 +79,15 @@ func RedshiftCatalogResourceSchema(ctx context.Context) schema.Schema {
 			},
 			"secret_key": schema.StringAttribute{
 				Optional:            true,
-				Computed:            true,
-				Description:         "",
-				MarkdownDescription: "",
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "AWS secret key",
+				MarkdownDescription: "AWS secret key",
+			},
+			"secret_key_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of secret_key. Because secret_key is write-only, Terraform cannot detect changes to it; change this value to send an updated secret_key to Galaxy.",
+				MarkdownDescription: "Version of secret_key. Because secret_key is write-only, Terraform cannot detect changes to it; change this value to send an updated secret_key to Galaxy.",
 			},
 			"ssh_tunnel_id": schema.StringAttribute{
 				Optional:            true,
@@ -95,18 +112,21 @@ func RedshiftCatalogResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type RedshiftCatalogModel struct {
-	AccessKey   types.String `tfsdk:"access_key"`
-	AuthType    types.String `tfsdk:"auth_type"`
-	CatalogId   types.String `tfsdk:"catalog_id"`
-	Description types.String `tfsdk:"description"`
-	Endpoint    types.String `tfsdk:"endpoint"`
-	Name        types.String `tfsdk:"name"`
-	Password    types.String `tfsdk:"password"`
-	ReadOnly    types.Bool   `tfsdk:"read_only"`
-	Region      types.String `tfsdk:"region"`
-	RoleArn     types.String `tfsdk:"role_arn"`
-	SecretKey   types.String `tfsdk:"secret_key"`
-	SshTunnelId types.String `tfsdk:"ssh_tunnel_id"`
-	Username    types.String `tfsdk:"username"`
-	Validate    types.Bool   `tfsdk:"validate"`
+	AccessKey        types.String `tfsdk:"access_key"`
+	AccessKeyVersion types.Int64  `tfsdk:"access_key_version"`
+	AuthType         types.String `tfsdk:"auth_type"`
+	CatalogId        types.String `tfsdk:"catalog_id"`
+	Description      types.String `tfsdk:"description"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	Name             types.String `tfsdk:"name"`
+	Password         types.String `tfsdk:"password"`
+	PasswordVersion  types.Int64  `tfsdk:"password_version"`
+	ReadOnly         types.Bool   `tfsdk:"read_only"`
+	Region           types.String `tfsdk:"region"`
+	RoleArn          types.String `tfsdk:"role_arn"`
+	SecretKey        types.String `tfsdk:"secret_key"`
+	SecretKeyVersion types.Int64  `tfsdk:"secret_key_version"`
+	SshTunnelId      types.String `tfsdk:"ssh_tunnel_id"`
+	Username         types.String `tfsdk:"username"`
+	Validate         types.Bool   `tfsdk:"validate"`
 }
@@ -14,9 +14,15 @@ func S3CatalogResourceSchema(ctx context.Context) schema.Schema {
 		Attributes: map[string]schema.Attribute{
 			"access_key": schema.StringAttribute{
 				Optional:            true,
-				Computed:            true,
-				Description:         "",
-				MarkdownDescription: "",
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "AWS access key",
+				MarkdownDescription: "AWS access key",
+			},
+			"access_key_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of access_key. Because access_key is write-only, Terraform cannot detect changes to it; change this value to send an updated access_key to Galaxy.",
+				MarkdownDescription: "Version of access_key. Because access_key is write-only, Terraform cannot detect changes to it; change this value to send an updated access_key to Galaxy.",
 			},
 			"catalog_id": schema.StringAttribute{
 				Computed:            true,
@@ -73,9 +79,15 @@ func S3CatalogResourceSchema(ctx context.Context) schema.Schema {
 			},
 			"glue_secret_key": schema.StringAttribute{
 				Optional:            true,
-				Computed:            true,
-				Description:         "",
-				MarkdownDescription: "",
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "AWS Glue secret key",
+				MarkdownDescription: "AWS Glue secret key",
+			},
+			"glue_secret_key_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of glue_secret_key. Because glue_secret_key is write-only, Terraform cannot detect changes to it; change this value to send an updated glue_secret_key to Galaxy.",
+				MarkdownDescription: "Version of glue_secret_key. Because glue_secret_key is write-only, Terraform cannot detect changes to it; change this value to send an updated glue_secret_key to Galaxy.",
 			},
 			"hive_metastore_host": schema.StringAttribute{
 				Optional:            true,
@@ -116,9 +128,15 @@ func S3CatalogResourceSchema(ctx context.Context) schema.Schema {
 			},
 			"secret_key": schema.StringAttribute{
 				Optional:            true,
-				Computed:            true,
-				Description:         "",
-				MarkdownDescription: "",
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "AWS secret key",
+				MarkdownDescription: "AWS secret key",
+			},
+			"secret_key_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of secret_key. Because secret_key is write-only, Terraform cannot detect changes to it; change this value to send an updated secret_key to Galaxy.",
+				MarkdownDescription: "Version of secret_key. Because secret_key is write-only, Terraform cannot detect changes to it; change this value to send an updated secret_key to Galaxy.",
 			},
 			"ssh_tunnel_id": schema.StringAttribute{
 				Optional:            true,
@@ -138,6 +156,7 @@ func S3CatalogResourceSchema(ctx context.Context) schema.Schema {
 
 type S3CatalogModel struct {
 	AccessKey                    types.String `tfsdk:"access_key"`
+	AccessKeyVersion             types.Int64  `tfsdk:"access_key_version"`
 	CatalogId                    types.String `tfsdk:"catalog_id"`
 	DefaultBucket                types.String `tfsdk:"default_bucket"`
 	DefaultDataLocation          types.String `tfsdk:"default_data_location"`
@@ -148,6 +167,7 @@ type S3CatalogModel struct {
 	GlueAccessKey                types.String `tfsdk:"glue_access_key"`
 	GlueRoleArn                  types.String `tfsdk:"glue_role_arn"`
 	GlueSecretKey                types.String `tfsdk:"glue_secret_key"`
+	GlueSecretKeyVersion         types.Int64  `tfsdk:"glue_secret_key_version"`
 	HiveMetastoreHost            types.String `tfsdk:"hive_metastore_host"`
 	HiveMetastorePort            types.Int64  `tfsdk:"hive_metastore_port"`
 	MetastoreType                types.String `tfsdk:"metastore_type"`
@@ -156,6 +176,7 @@ type S3CatalogModel struct {
 	Region                       types.String `tfsdk:"region"`
 	RoleArn                      types.String `tfsdk:"role_arn"`
 	SecretKey                    types.String `tfsdk:"secret_key"`
+	SecretKeyVersion             types.Int64  `tfsdk:"secret_key_version"`
 	SshTunnelId                  types.String `tfsdk:"ssh_tunnel_id"`
 	Validate                     types.Bool   `tfsdk:"validate"`
 }
@@ -66,6 +66,11 @@ func SnowflakeCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "",
 				MarkdownDescription: "",
 			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+				MarkdownDescription: "Version of password. Because password is write-only, Terraform cannot detect changes to it; change this value to send an updated password to Galaxy.",
+			},
 			"private_key": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -73,6 +78,11 @@ func SnowflakeCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "",
 				MarkdownDescription: "",
 			},
+			"private_key_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of private_key. Because private_key is write-only, Terraform cannot detect changes to it; change this value to send an updated private_key to Galaxy.",
+				MarkdownDescription: "Version of private_key. Because private_key is write-only, Terraform cannot detect changes to it; change this value to send an updated private_key to Galaxy.",
+			},
 			"private_key_passphrase": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -80,6 +90,11 @@ func SnowflakeCatalogResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "",
 				MarkdownDescription: "",
 			},
+			"private_key_passphrase_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Version of private_key_passphrase. Because private_key_passphrase is write-only, Terraform cannot detect changes to it; change this value to send an updated private_key_passphrase to Galaxy.",
+				MarkdownDescription: "Version of private_key_passphrase. Because private_key_passphrase is write-only, Terraform cannot detect changes to it; change this value to send an updated private_key_passphrase to Galaxy.",
+			},
 			"read_only": schema.BoolAttribute{
 				Required:            true,
 				Description:         "Is catalog read only",
@@ -113,19 +128,22 @@ func SnowflakeCatalogResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type SnowflakeCatalogModel struct {
-	AccountIdentifier    types.String `tfsdk:"account_identifier"`
-	AuthenticationType   types.String `tfsdk:"authentication_type"`
-	CatalogId            types.String `tfsdk:"catalog_id"`
-	CloudKind            types.String `tfsdk:"cloud_kind"`
-	DatabaseName         types.String `tfsdk:"database_name"`
-	Description          types.String `tfsdk:"description"`
-	Name                 types.String `tfsdk:"name"`
-	Password             types.String `tfsdk:"password"`
-	PrivateKey           types.String `tfsdk:"private_key"`
-	PrivateKeyPassphrase types.String `tfsdk:"private_key_passphrase"`
-	ReadOnly             types.Bool   `tfsdk:"read_only"`
-	Role                 types.String `tfsdk:"role"`
-	Username             types.String `tfsdk:"username"`
-	Validate             types.Bool   `tfsdk:"validate"`
-	Warehouse            types.String `tfsdk:"warehouse"`
+	AccountIdentifier           types.String `tfsdk:"account_identifier"`
+	AuthenticationType          types.String `tfsdk:"authentication_type"`
+	CatalogId                   types.String `tfsdk:"catalog_id"`
+	CloudKind                   types.String `tfsdk:"cloud_kind"`
+	DatabaseName                types.String `tfsdk:"database_name"`
+	Description                 types.String `tfsdk:"description"`
+	Name                        types.String `tfsdk:"name"`
+	Password                    types.String `tfsdk:"password"`
+	PasswordVersion             types.Int64  `tfsdk:"password_version"`
+	PrivateKey                  types.String `tfsdk:"private_key"`
+	PrivateKeyVersion           types.Int64  `tfsdk:"private_key_version"`
+	PrivateKeyPassphrase        types.String `tfsdk:"private_key_passphrase"`
+	PrivateKeyPassphraseVersion types.Int64  `tfsdk:"private_key_passphrase_version"`
+	ReadOnly                    types.Bool   `tfsdk:"read_only"`
+	Role                        types.String `tfsdk:"role"`
+	Username                    types.String `tfsdk:"username"`
+	Validate                    types.Bool   `tfsdk:"validate"`
+	Warehouse                   types.String `tfsdk:"warehouse"`
 }
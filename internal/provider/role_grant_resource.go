@@ -189,10 +189,7 @@ func (r *roleGrantResource) Create(ctx context.Context, req resource.CreateReque
 	_, err = r.client.UpdateRoleGrants(ctx, roleID, grants)
 	r.client.UnlockRole(roleID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating role grant",
-			"Could not update role grants: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating role grant", "Could not update role grants", err)
 		return
 	}
 
@@ -112,10 +112,7 @@ func (r *role_privilege_grantResource) Create(ctx context.Context, req resource.
 	tflog.Debug(ctx, "Creating role_privilege_grant")
 	response, err := r.client.CreateRolePrivilegeGrant(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating role_privilege_grant",
-			"Could not create role_privilege_grant: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating role_privilege_grant", "Could not create role_privilege_grant", err)
 		return
 	}
 
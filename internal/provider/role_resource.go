@@ -99,10 +99,7 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Debug(ctx, "Creating role")
 	response, err := r.client.CreateRole(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating role",
-			"Could not create role: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating role", "Could not create role", err)
 		return
 	}
 
@@ -186,10 +183,7 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	tflog.Debug(ctx, "Updating role", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateRole(ctx, id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating role",
-			"Could not update role "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating role", "Could not update role "+id, err)
 		return
 	}
 
@@ -101,10 +101,7 @@ func (r *row_filterResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Debug(ctx, "Creating row_filter")
 	response, err := r.client.CreateRowFilter(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating row_filter",
-			"Could not create row_filter: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating row_filter", "Could not create row_filter", err)
 		return
 	}
 
@@ -173,10 +170,7 @@ func (r *row_filterResource) Update(ctx context.Context, req resource.UpdateRequ
 	tflog.Debug(ctx, "Updating row_filter", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateRowFilter(ctx, id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating row_filter",
-			"Could not update row_filter "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating row_filter", "Could not update row_filter "+id, err)
 		return
 	}
 
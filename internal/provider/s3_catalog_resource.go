@@ -28,7 +28,6 @@ import (
 
 var _ resource.Resource = (*s3_catalogResource)(nil)
 var _ resource.ResourceWithConfigure = (*s3_catalogResource)(nil)
-var _ resource.ResourceWithModifyPlan = (*s3_catalogResource)(nil)
 var _ resource.ResourceWithImportState = (*s3_catalogResource)(nil)
 
 func NewS3CatalogResource() resource.Resource {
@@ -93,21 +92,22 @@ func (r *s3_catalogResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Initialize optional computed fields to null if not provided in config (before API call)
-	if plan.AccessKey.IsUnknown() {
-		plan.AccessKey = types.StringNull()
-	}
-	if plan.SecretKey.IsUnknown() {
-		plan.SecretKey = types.StringNull()
-	}
 	if plan.GlueAccessKey.IsUnknown() {
 		plan.GlueAccessKey = types.StringNull()
 	}
 	if plan.GlueRoleArn.IsUnknown() {
 		plan.GlueRoleArn = types.StringNull()
 	}
-	if plan.GlueSecretKey.IsUnknown() {
-		plan.GlueSecretKey = types.StringNull()
+
+	// access_key, secret_key and glue_secret_key are WriteOnly: read from req.Config.
+	var config resource_s3_catalog.S3CatalogModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	plan.AccessKey = config.AccessKey
+	plan.SecretKey = config.SecretKey
+	plan.GlueSecretKey = config.GlueSecretKey
 
 	request := r.modelToCreateRequest(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -117,10 +117,7 @@ func (r *s3_catalogResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Debug(ctx, "Creating s3_catalog")
 	response, err := r.client.CreateCatalog(ctx, "s3", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating s3_catalog",
-			"Could not create s3_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating s3_catalog", "Could not create s3_catalog", err)
 		return
 	}
 
@@ -180,6 +177,16 @@ func (r *s3_catalogResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// access_key, secret_key and glue_secret_key are WriteOnly: read from req.Config.
+	var config resource_s3_catalog.S3CatalogModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.AccessKey = config.AccessKey
+	plan.SecretKey = config.SecretKey
+	plan.GlueSecretKey = config.GlueSecretKey
+
 	id := state.CatalogId.ValueString()
 	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -189,10 +196,7 @@ func (r *s3_catalogResource) Update(ctx context.Context, req resource.UpdateRequ
 	tflog.Debug(ctx, "Updating s3_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "s3", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating s3_catalog",
-			"Could not update s3_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating s3_catalog", "Could not update s3_catalog "+id, err)
 		return
 	}
 
@@ -234,33 +238,6 @@ func (r *s3_catalogResource) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root("catalog_id"), req, resp)
 }
 
-// ModifyPlan ensures mutually exclusive credentials are enforced at plan time.
-// If role_arn is set we forcibly null out access_key and secret_key so they are
-// not sent in update requests even if they remain in prior state.
-func (r *s3_catalogResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// If plan is null (destroy) exit
-	if req.Plan.Raw.IsNull() {
-		return
-	}
-
-	var plan resource_s3_catalog.S3CatalogModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Handle regular S3 authentication mutual exclusivity
-	if !plan.RoleArn.IsNull() && plan.RoleArn.ValueString() != "" {
-		// Always null keys if roleArn set
-		if !plan.AccessKey.IsNull() || !plan.SecretKey.IsNull() {
-			tflog.Debug(ctx, "Nulling access_key/secret_key because role_arn is set")
-		}
-		plan.AccessKey = types.StringNull()
-		plan.SecretKey = types.StringNull()
-		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
-	}
-}
-
 // Helper methods
 func (r *s3_catalogResource) modelToCreateRequest(ctx context.Context, model *resource_s3_catalog.S3CatalogModel, diags *diag.Diagnostics) map[string]interface{} {
 	request := make(map[string]interface{})
@@ -446,22 +423,10 @@ func (r *s3_catalogResource) updateModelFromResponse(ctx context.Context, model
 		model.SshTunnelId = types.StringNull()
 	}
 
-	// Handle authentication fields for all metastore types
-	if accessKey, ok := response["accessKey"].(string); ok && accessKey != "" {
-		model.AccessKey = types.StringValue(accessKey)
-	} else {
-		model.AccessKey = types.StringNull()
-	}
-
-	if secretKey, ok := response["secretKey"].(string); ok && secretKey != "" && secretKey != "<Value is encrypted>" {
-		model.SecretKey = types.StringValue(secretKey)
-	}
-
+	// Handle authentication fields for all metastore types.
+	// access_key and secret_key are write-only, keep existing values.
 	if roleArn, ok := response["roleArn"].(string); ok {
 		model.RoleArn = types.StringValue(roleArn)
-		// Clear keys in state if roleArn now governs auth
-		model.AccessKey = types.StringNull()
-		model.SecretKey = types.StringNull()
 	} else {
 		model.RoleArn = types.StringNull()
 	}
@@ -490,11 +455,7 @@ func (r *s3_catalogResource) updateModelFromResponse(ctx context.Context, model
 		model.GlueRoleArn = types.StringNull()
 	}
 
-	if glueSecretKey, ok := response["glueSecretKey"].(string); ok && glueSecretKey != "" && glueSecretKey != "<Value is encrypted>" {
-		model.GlueSecretKey = types.StringValue(glueSecretKey)
-	} else {
-		model.GlueSecretKey = types.StringNull()
-	}
+	// glue_secret_key is write-only, keep existing value
 
 	// Handle validate field
 	if validate, ok := response["validate"].(bool); ok {
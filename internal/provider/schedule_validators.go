@@ -0,0 +1,164 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	// Embed the IANA time zone database so timezone validation does not depend on the
+	// zoneinfo files of the machine running Terraform (absent on Windows and slim images).
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = cronExpressionValidator{}
+var _ validator.String = timezoneValidator{}
+
+// cronField describes the allowed range and symbolic names of one cron field.
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+// cronFields lists the five fields of a standard cron expression, in order.
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	// 7 is accepted as an alias for Sunday.
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// cronExpressionValidator checks that a string is a five-field cron expression
+// (minute hour day-of-month month day-of-week) supporting *, lists, ranges and steps.
+type cronExpressionValidator struct{}
+
+func (v cronExpressionValidator) Description(ctx context.Context) string {
+	return "value must be a five-field cron expression (minute hour day-of-month month day-of-week)"
+}
+
+func (v cronExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateCronExpression(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Expression",
+			fmt.Sprintf("%q is not a valid cron expression: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+func validateCronExpression(expression string) error {
+	parts := strings.Fields(expression)
+	if len(parts) != len(cronFields) {
+		return fmt.Errorf("expected %d space-separated fields, got %d", len(cronFields), len(parts))
+	}
+
+	for i, part := range parts {
+		if err := validateCronField(part, cronFields[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCronField(value string, field cronField) error {
+	for _, item := range strings.Split(value, ",") {
+		rangePart, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return fmt.Errorf("%s field has invalid step %q", field.name, step)
+			}
+		}
+
+		if rangePart == "*" {
+			continue
+		}
+
+		// A single value with a step, such as "5/15", is shorthand for "5-max/15".
+		lowText, highText, isRange := strings.Cut(rangePart, "-")
+		low, err := parseCronValue(lowText, field)
+		if err != nil {
+			return err
+		}
+		if isRange {
+			high, err := parseCronValue(highText, field)
+			if err != nil {
+				return err
+			}
+			if low > high {
+				return fmt.Errorf("%s field has descending range %q", field.name, rangePart)
+			}
+		}
+	}
+	return nil
+}
+
+func parseCronValue(text string, field cronField) (int, error) {
+	if n, ok := field.names[strings.ToUpper(text)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("%s field has invalid value %q", field.name, text)
+	}
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("%s field value %d is outside %d-%d", field.name, n, field.min, field.max)
+	}
+	return n, nil
+}
+
+// timezoneValidator checks that a string is an IANA time zone name such as "UTC" or
+// "America/New_York".
+type timezoneValidator struct{}
+
+func (v timezoneValidator) Description(ctx context.Context) string {
+	return "value must be an IANA time zone name, for example UTC or America/New_York"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// time.LoadLocation treats "" as UTC and accepts "Local"; neither is meaningful to Galaxy.
+	name := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timezone",
+			fmt.Sprintf("%q is not a valid IANA time zone name, for example UTC or America/New_York.", name),
+		)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCronExpressionValidator(t *testing.T) {
+	cases := []struct {
+		expression string
+		valid      bool
+	}{
+		{"0 3 * * *", true},
+		{"*/15 * * * *", true},
+		{"0 8-18/2 * * MON-FRI", true},
+		{"30 4 1,15 jan,jul 0", true},
+		{"0 0 * * 7", true},
+		{"5/10 * * * *", true},
+		{"0 3 * *", false},
+		{"0 3 * * * *", false},
+		{"60 * * * *", false},
+		{"0 24 * * *", false},
+		{"0 0 0 * *", false},
+		{"0 0 * 13 *", false},
+		{"0 18-8 * * *", false},
+		{"*/0 * * * *", false},
+		{"0 3 * * FUNDAY", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.expression, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("cron_expression"), ConfigValue: types.StringValue(tc.expression)}
+			resp := &validator.StringResponse{}
+			cronExpressionValidator{}.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() == tc.valid {
+				t.Errorf("expected valid=%t, got diagnostics: %v", tc.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTimezoneValidator(t *testing.T) {
+	cases := []struct {
+		timezone string
+		valid    bool
+	}{
+		{"UTC", true},
+		{"America/New_York", true},
+		{"Europe/Berlin", true},
+		{"", false},
+		{"Local", false},
+		{"Mars/Olympus_Mons", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.timezone, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("timezone"), ConfigValue: types.StringValue(tc.timezone)}
+			resp := &validator.StringResponse{}
+			timezoneValidator{}.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() == tc.valid {
+				t.Errorf("expected valid=%t, got diagnostics: %v", tc.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ ephemeral.EphemeralResource = (*serviceAccountPasswordEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithConfigure = (*serviceAccountPasswordEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithClose = (*serviceAccountPasswordEphemeralResource)(nil)
+
+// serviceAccountPasswordPrivateKey is the private data key under which Open records a
+// minted password so Close can revoke it.
+const serviceAccountPasswordPrivateKey = "revoke_password"
+
+func NewServiceAccountPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &serviceAccountPasswordEphemeralResource{}
+}
+
+type serviceAccountPasswordEphemeralResource struct {
+	client *client.GalaxyClient
+}
+
+type serviceAccountPasswordEphemeralModel struct {
+	ServiceAccountId         types.String `tfsdk:"service_account_id"`
+	ServiceAccountPasswordId types.String `tfsdk:"service_account_password_id"`
+	Description              types.String `tfsdk:"description"`
+	RevokeOnClose            types.Bool   `tfsdk:"revoke_on_close"`
+	Password                 types.String `tfsdk:"password"`
+	PasswordPrefix           types.String `tfsdk:"password_prefix"`
+	Created                  types.String `tfsdk:"created"`
+	LastLogin                types.String `tfsdk:"last_login"`
+}
+
+// serviceAccountPasswordRevocation is the private data recorded by Open.
+type serviceAccountPasswordRevocation struct {
+	ServiceAccountID string `json:"service_account_id"`
+	PasswordID       string `json:"service_account_password_id"`
+}
+
+func (r *serviceAccountPasswordEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_password"
+}
+
+func (r *serviceAccountPasswordEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Mints a service account password for the duration of a Terraform run without persisting it to state or plan. " +
+			"Use it to pipe a password into a write-only argument or a secrets manager. " +
+			"When service_account_password_id is set, the existing password is read instead; Galaxy only returns the password value when it is created, so password is null in that mode.",
+		Attributes: map[string]schema.Attribute{
+			"service_account_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service account",
+			},
+			"service_account_password_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of an existing password to read. When omitted, a new password is created and its ID is returned here.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the created password. Cannot be set together with service_account_password_id.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("service_account_password_id")),
+				},
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Delete the created password when Terraform closes the ephemeral resource at the end of the run. Defaults to true; set to false when the password is handed to a secrets manager and must outlive the run. Terraform opens ephemeral resources on every plan and apply, so with revocation disabled each run leaves a new password behind. Cannot be set together with service_account_password_id.",
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("service_account_password_id")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The fully-constructed service account password credential (GXY$<prefix><password>). Null when reading an existing password.",
+				MarkdownDescription: "The fully-constructed service account password credential (`GXY$<prefix><password>`). Null when reading an existing password.",
+			},
+			"password_prefix": schema.StringAttribute{
+				Computed:    true,
+				Description: "Service account password prefix",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation timestamp of the password",
+			},
+			"last_login": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last login timestamp of the password",
+			},
+		},
+	}
+}
+
+func (r *serviceAccountPasswordEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *serviceAccountPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config serviceAccountPasswordEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceAccountID := config.ServiceAccountId.ValueString()
+
+	if !config.ServiceAccountPasswordId.IsNull() && !config.ServiceAccountPasswordId.IsUnknown() {
+		passwordID := config.ServiceAccountPasswordId.ValueString()
+		tflog.Debug(ctx, "Reading ephemeral service_account_password", map[string]interface{}{
+			"service_account_id": serviceAccountID,
+			"password_id":        passwordID,
+		})
+
+		response, err := r.client.GetServiceAccountPassword(ctx, serviceAccountID, passwordID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading service_account_password",
+				"Could not read service_account_password "+passwordID+": "+err.Error(),
+			)
+			return
+		}
+
+		r.updateModelFromResponse(&config, response, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+		return
+	}
+
+	request := make(map[string]interface{})
+	if !config.Description.IsNull() && config.Description.ValueString() != "" {
+		request["description"] = config.Description.ValueString()
+	}
+
+	tflog.Debug(ctx, "Creating ephemeral service_account_password", map[string]interface{}{"service_account_id": serviceAccountID})
+	response, err := r.client.CreateServiceAccountPassword(ctx, serviceAccountID, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating service_account_password",
+			"Could not create service_account_password: "+err.Error(),
+		)
+		return
+	}
+
+	// From here on a password exists. If anything fails before it is recorded for Close,
+	// delete it right away rather than leaving a live credential nobody can revoke.
+	passwordID := getStringFromMap(response, "serviceAccountPasswordId")
+	r.updateModelFromResponse(&config, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		r.revokeAfterFailedOpen(ctx, serviceAccountID, passwordID, &resp.Diagnostics)
+		return
+	}
+
+	// Record the password for revocation in Close unless the user opted out.
+	if config.RevokeOnClose.IsNull() || config.RevokeOnClose.ValueBool() {
+		private, err := json.Marshal(serviceAccountPasswordRevocation{
+			ServiceAccountID: serviceAccountID,
+			PasswordID:       passwordID,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating service_account_password",
+				"Could not record password for revocation: "+err.Error(),
+			)
+			r.revokeAfterFailedOpen(ctx, serviceAccountID, passwordID, &resp.Diagnostics)
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, serviceAccountPasswordPrivateKey, private)...)
+		if resp.Diagnostics.HasError() {
+			r.revokeAfterFailedOpen(ctx, serviceAccountID, passwordID, &resp.Diagnostics)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Created ephemeral service_account_password", map[string]interface{}{"id": config.ServiceAccountPasswordId.ValueString()})
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+func (r *serviceAccountPasswordEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, serviceAccountPasswordPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var revocation serviceAccountPasswordRevocation
+	if err := json.Unmarshal(private, &revocation); err != nil {
+		resp.Diagnostics.AddError(
+			"Error revoking service_account_password",
+			"Could not decode password recorded for revocation: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Revoking ephemeral service_account_password", map[string]interface{}{
+		"service_account_id": revocation.ServiceAccountID,
+		"password_id":        revocation.PasswordID,
+	})
+	err := r.client.DeleteServiceAccountPassword(ctx, revocation.ServiceAccountID, revocation.PasswordID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error revoking service_account_password",
+			"Could not delete service_account_password "+revocation.PasswordID+": "+err.Error(),
+		)
+	}
+}
+
+// revokeAfterFailedOpen deletes a password created by an Open that then failed, since Close is
+// not called for it. Without an ID in the create response there is nothing to delete, so the
+// user is told to clean up manually.
+func (r *serviceAccountPasswordEphemeralResource) revokeAfterFailedOpen(ctx context.Context, serviceAccountID, passwordID string, diags *diag.Diagnostics) {
+	if passwordID == "" {
+		diags.AddError(
+			"Error revoking service_account_password",
+			"A password was created for service account "+serviceAccountID+" but its ID is unknown, so it could not be revoked. Delete it in Galaxy.",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Revoking ephemeral service_account_password after failed open", map[string]interface{}{
+		"service_account_id": serviceAccountID,
+		"password_id":        passwordID,
+	})
+	err := r.client.DeleteServiceAccountPassword(ctx, serviceAccountID, passwordID)
+	if err != nil && !client.IsNotFound(err) {
+		diags.AddError(
+			"Error revoking service_account_password",
+			"Could not delete service_account_password "+passwordID+" created by the failed open: "+err.Error(),
+		)
+	}
+}
+
+// Helper methods
+func (r *serviceAccountPasswordEphemeralResource) updateModelFromResponse(model *serviceAccountPasswordEphemeralModel, response map[string]interface{}, diags *diag.Diagnostics) {
+	model.ServiceAccountPasswordId = types.StringValue(getStringFromMap(response, "serviceAccountPasswordId"))
+	if model.ServiceAccountPasswordId.ValueString() == "" {
+		diags.AddError("Unexpected API response", "service_account_password: response did not include serviceAccountPasswordId")
+		return
+	}
+
+	if description, ok := response["description"].(string); ok {
+		model.Description = types.StringValue(description)
+	}
+
+	passwordRaw, hasPassword := response["password"].(string)
+	passwordPrefix, hasPrefix := response["passwordPrefix"].(string)
+	prefixPresent := hasPrefix && passwordPrefix != ""
+
+	if hasPassword && prefixPresent {
+		model.Password = types.StringValue("GXY$" + passwordPrefix + passwordRaw)
+	} else if hasPassword {
+		diags.AddError("Unexpected API response", "service_account_password: received password without passwordPrefix")
+		return
+	} else {
+		model.Password = types.StringNull()
+	}
+
+	model.PasswordPrefix = types.StringNull()
+	if prefixPresent {
+		model.PasswordPrefix = types.StringValue(passwordPrefix)
+	}
+
+	model.Created = types.StringNull()
+	if created, ok := response["created"].(string); ok {
+		model.Created = types.StringValue(created)
+	}
+
+	model.LastLogin = types.StringNull()
+	if lastLogin, ok := response["lastLogin"].(string); ok {
+		model.LastLogin = types.StringValue(lastLogin)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testAccEphemeralProtoV6ProviderFactories adds the echo provider, which copies ephemeral
+// values into state so tests can inspect them.
+var testAccEphemeralProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"galaxy": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":   echoprovider.NewProviderServer(),
+}
+
+func TestAccEphemeralServiceAccountPassword_Basic(t *testing.T) {
+	suffix := testSuffix
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccEphemeralProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralServiceAccountPasswordConfig(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.password",
+						tfjsonpath.New("data").AtMapKey("password"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^GXY\$`)),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.password",
+						tfjsonpath.New("data").AtMapKey("service_account_password_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.password",
+						tfjsonpath.New("data").AtMapKey("description"),
+						knownvalue.StringExact("Ephemeral test password"),
+					),
+				},
+			},
+		},
+	})
+}
+
+// testAccEphemeralServiceAccountPasswordConfig mints an ephemeral password and echoes it into state
+func testAccEphemeralServiceAccountPasswordConfig(suffix string) string {
+	return fmt.Sprintf(`
+resource "galaxy_service_account" "test" {
+  username              = "tfaccsaeph_%[1]s"
+  with_initial_password = false
+  additional_role_ids   = []
+}
+
+ephemeral "galaxy_service_account_password" "test" {
+  service_account_id = galaxy_service_account.test.service_account_id
+  description        = "Ephemeral test password"
+}
+
+provider "echo" {
+  data = ephemeral.galaxy_service_account_password.test
+}
+
+resource "echo" "password" {}
+`, suffix)
+}
+
+// TestEphemeralServiceAccountPasswordOpenRevokesOnFailure checks that a password created by an
+// Open that then fails is deleted, since Terraform never calls Close for it.
+func TestEphemeralServiceAccountPasswordOpenRevokesOnFailure(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	c := newFakeGalaxyClient(t, func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		mu.Unlock()
+		if req.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		// A password without its prefix cannot be turned into a usable credential
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"serviceAccountPasswordId": "uat-1", "password": "secret"}`))
+	})
+
+	ctx := context.Background()
+	r := &serviceAccountPasswordEphemeralResource{client: c}
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := config.Set(ctx, &serviceAccountPasswordEphemeralModel{
+		ServiceAccountId:         types.StringValue("u-1"),
+		ServiceAccountPasswordId: types.StringNull(),
+		Description:              types.StringNull(),
+		RevokeOnClose:            types.BoolNull(),
+		Password:                 types.StringNull(),
+		PasswordPrefix:           types.StringNull(),
+		Created:                  types.StringNull(),
+		LastLogin:                types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("could not build config: %v", diags)
+	}
+
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected Open to fail on a password without prefix")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(requests) != 2 || requests[1] != "DELETE /public/api/v1/serviceAccount/u-1/serviceAccountPassword/uat-1" {
+		t.Errorf("expected the created password to be deleted, got requests %v", requests)
+	}
+}
@@ -13,13 +13,16 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -29,6 +32,7 @@ import (
 
 var _ resource.Resource = (*service_account_passwordResource)(nil)
 var _ resource.ResourceWithConfigure = (*service_account_passwordResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*service_account_passwordResource)(nil)
 var _ resource.ResourceWithImportState = (*service_account_passwordResource)(nil)
 
 func NewServiceAccountPasswordResource() resource.Resource {
@@ -39,10 +43,15 @@ type service_account_passwordResource struct {
 	client *client.GalaxyClient
 }
 
-// Extended model with service account ID
+// Extended model with service account ID and rotation settings
 type ServiceAccountPasswordModelExtended struct {
 	resource_service_account_password.ServiceAccountPasswordModel
-	ServiceAccountId types.String `tfsdk:"service_account_id"`
+	ServiceAccountId                 types.String `tfsdk:"service_account_id"`
+	RotationDays                     types.Int64  `tfsdk:"rotation_days"`
+	RotationOverlapHours             types.Int64  `tfsdk:"rotation_overlap_hours"`
+	RotateWhenChanged                types.Map    `tfsdk:"rotate_when_changed"`
+	ExpiresAt                        types.String `tfsdk:"expires_at"`
+	PreviousServiceAccountPasswordId types.String `tfsdk:"previous_service_account_password_id"`
 }
 
 func (r *service_account_passwordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -81,8 +90,46 @@ func (r *service_account_passwordResource) Schema(ctx context.Context, req resou
 		},
 	}
 
-	// created is assigned at creation and never changes. Without UseStateForUnknown, any update
-	// to the service account password causes Terraform to mark created as "known after apply".
+	baseSchema.Attributes["rotation_days"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: "Number of days after creation at which the password expires. Once it has expired, the next plan rotates it: a new password is created before the old one is deleted.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+
+	baseSchema.Attributes["rotation_overlap_hours"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: "Number of hours the previous password stays valid after a rotation, so clients can switch to the new one. The previous password is deleted by the first apply after the window ends. Defaults to 0, which deletes it during the rotation.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+
+	baseSchema.Attributes["rotate_when_changed"] = schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Arbitrary map of values that, when changed, rotates the password.",
+	}
+
+	baseSchema.Attributes["expires_at"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Time at which the password expires (RFC 3339), computed from created and rotation_days. Null when rotation_days is not set.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	baseSchema.Attributes["previous_service_account_password_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "ID of the password replaced by the last rotation while it remains valid during rotation_overlap_hours",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	// created is assigned at creation and only changes on rotation. Without UseStateForUnknown,
+	// any update to the service account password causes Terraform to mark created as "known after apply".
 	if attr, ok := baseSchema.Attributes["created"].(schema.StringAttribute); ok {
 		attr.PlanModifiers = []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
@@ -90,7 +137,7 @@ func (r *service_account_passwordResource) Schema(ctx context.Context, req resou
 		baseSchema.Attributes["created"] = attr
 	}
 
-	// service_account_password_id is assigned at creation and never changes. Without
+	// service_account_password_id is assigned at creation and only changes on rotation. Without
 	// UseStateForUnknown, any update to the service account password causes Terraform to mark
 	// service_account_password_id as "known after apply".
 	if attr, ok := baseSchema.Attributes["service_account_password_id"].(schema.StringAttribute); ok {
@@ -139,10 +186,7 @@ func (r *service_account_passwordResource) Create(ctx context.Context, req resou
 	tflog.Debug(ctx, "Creating service_account_password", map[string]interface{}{"service_account_id": serviceAccountID})
 	response, err := r.client.CreateServiceAccountPassword(ctx, serviceAccountID, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating service_account_password",
-			"Could not create service_account_password: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating service_account_password", "Could not create service_account_password", err)
 		return
 	}
 
@@ -150,6 +194,7 @@ func (r *service_account_passwordResource) Create(ctx context.Context, req resou
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.PreviousServiceAccountPasswordId = types.StringNull()
 
 	tflog.Debug(ctx, "Created service_account_password", map[string]interface{}{"id": plan.ServiceAccountPasswordId.ValueString()})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -191,6 +236,21 @@ func (r *service_account_passwordResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	// Forget the previous password if it was deleted outside Terraform
+	if !state.PreviousServiceAccountPasswordId.IsNull() {
+		previousID := state.PreviousServiceAccountPasswordId.ValueString()
+		if _, err := r.client.GetServiceAccountPassword(ctx, serviceAccountID, previousID); err != nil {
+			if !client.IsNotFound(err) {
+				resp.Diagnostics.AddError(
+					"Error reading service_account_password",
+					"Could not read previous service_account_password "+previousID+": "+err.Error(),
+				)
+				return
+			}
+			state.PreviousServiceAccountPasswordId = types.StringNull()
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -208,6 +268,16 @@ func (r *service_account_passwordResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	// ModifyPlan marks service_account_password_id unknown when the password is due for rotation.
+	if plan.ServiceAccountPasswordId.IsUnknown() {
+		r.rotate(ctx, &plan, &state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	id := state.ServiceAccountPasswordId.ValueString()
 	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -217,10 +287,7 @@ func (r *service_account_passwordResource) Update(ctx context.Context, req resou
 	tflog.Debug(ctx, "Updating service_account_password", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateServiceAccountPassword(ctx, id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating service_account_password",
-			"Could not update service_account_password "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating service_account_password", "Could not update service_account_password "+id, err)
 		return
 	}
 
@@ -229,6 +296,11 @@ func (r *service_account_passwordResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	// ModifyPlan marks previous_service_account_password_id unknown once the overlap window has ended.
+	if plan.PreviousServiceAccountPasswordId.IsUnknown() {
+		plan.PreviousServiceAccountPasswordId = r.deletePreviousPassword(ctx, plan.ServiceAccountId.ValueString(), state.PreviousServiceAccountPasswordId, &resp.Diagnostics)
+	}
+
 	tflog.Debug(ctx, "Updated service_account_password", map[string]interface{}{"id": plan.ServiceAccountPasswordId.ValueString()})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -257,9 +329,83 @@ func (r *service_account_passwordResource) Delete(ctx context.Context, req resou
 		}
 	}
 
+	if !state.PreviousServiceAccountPasswordId.IsNull() {
+		previousID := state.PreviousServiceAccountPasswordId.ValueString()
+		err := r.client.DeleteServiceAccountPassword(ctx, serviceAccountID, previousID)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting service_account_password",
+				"Could not delete previous service_account_password "+previousID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
 	tflog.Debug(ctx, "Deleted service_account_password", map[string]interface{}{"service_account_id": serviceAccountID, "password_id": passwordID})
 }
 
+// ModifyPlan rotates the password when it has expired according to rotation_days or when
+// rotate_when_changed changes, and schedules deletion of the previous password once the
+// rotation overlap window has ended.
+func (r *service_account_passwordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ServiceAccountPasswordModelExtended
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	rotate := false
+
+	expiresAt, err := passwordExpiresAt(state.Created, plan.RotationDays)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("created"),
+			"Cannot determine password expiry",
+			"Could not parse created timestamp "+state.Created.ValueString()+": "+err.Error()+". The password will not be rotated based on rotation_days.",
+		)
+	} else if expiresAt != nil && !now.Before(*expiresAt) {
+		tflog.Info(ctx, "service_account_password has expired and will be rotated", map[string]interface{}{
+			"id":         state.ServiceAccountPasswordId.ValueString(),
+			"expires_at": expiresAt.Format(time.RFC3339),
+		})
+		rotate = true
+	}
+
+	if !plan.RotateWhenChanged.IsUnknown() && !plan.RotateWhenChanged.Equal(state.RotateWhenChanged) {
+		rotate = true
+	}
+
+	if rotate {
+		plan.ServiceAccountPasswordId = types.StringUnknown()
+		plan.Password = types.StringUnknown()
+		plan.PasswordPrefix = types.StringUnknown()
+		plan.Created = types.StringUnknown()
+		plan.LastLogin = types.StringUnknown()
+		plan.ExpiresAt = types.StringUnknown()
+		plan.PreviousServiceAccountPasswordId = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	plan.ExpiresAt = types.StringNull()
+	if expiresAt != nil {
+		plan.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	}
+
+	if !state.PreviousServiceAccountPasswordId.IsNull() && overlapEnded(state.Created, plan.RotationOverlapHours, now) {
+		plan.PreviousServiceAccountPasswordId = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *service_account_passwordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The ID should be in the format "service_account_id/password_id"
 	// For example: "u-4457989253/uat-4320576338177954"
@@ -342,4 +488,99 @@ func (r *service_account_passwordResource) updateModelFromResponse(ctx context.C
 	} else {
 		model.LastLogin = types.StringNull()
 	}
+
+	model.ExpiresAt = types.StringNull()
+	if expiresAt, err := passwordExpiresAt(model.Created, model.RotationDays); err == nil && expiresAt != nil {
+		model.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	}
+}
+
+// rotate creates a new password and then deletes the one it replaces, either immediately
+// or, when rotation_overlap_hours is set, on the first apply after the overlap window.
+func (r *service_account_passwordResource) rotate(ctx context.Context, plan, state *ServiceAccountPasswordModelExtended, diags *diag.Diagnostics) {
+	serviceAccountID := plan.ServiceAccountId.ValueString()
+	oldID := state.ServiceAccountPasswordId
+
+	request := r.modelToCreateRequest(ctx, plan, diags)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Rotating service_account_password", map[string]interface{}{
+		"service_account_id": serviceAccountID,
+		"password_id":        oldID.ValueString(),
+	})
+	response, err := r.client.CreateServiceAccountPassword(ctx, serviceAccountID, request)
+	if err != nil {
+		diags.AddError(
+			"Error rotating service_account_password",
+			"Could not create replacement service_account_password: "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, plan, response, diags)
+	if diags.HasError() {
+		return
+	}
+
+	// A password still in its overlap window from an earlier rotation is superseded now.
+	if !state.PreviousServiceAccountPasswordId.IsNull() {
+		if remaining := r.deletePreviousPassword(ctx, serviceAccountID, state.PreviousServiceAccountPasswordId, diags); !remaining.IsNull() {
+			tflog.Warn(ctx, "Orphaned service_account_password left after rotation", map[string]interface{}{"password_id": remaining.ValueString()})
+		}
+	}
+
+	if plan.RotationOverlapHours.ValueInt64() > 0 {
+		plan.PreviousServiceAccountPasswordId = oldID
+		return
+	}
+	plan.PreviousServiceAccountPasswordId = r.deletePreviousPassword(ctx, serviceAccountID, oldID, diags)
+
+	tflog.Debug(ctx, "Rotated service_account_password", map[string]interface{}{"id": plan.ServiceAccountPasswordId.ValueString()})
+}
+
+// deletePreviousPassword deletes a replaced password and returns the ID to keep in
+// previous_service_account_password_id: null once deleted, or the ID again if deletion failed
+// so the next apply retries it. Failures are reported as warnings because the new password
+// has already been created and must be saved to state.
+func (r *service_account_passwordResource) deletePreviousPassword(ctx context.Context, serviceAccountID string, passwordID types.String, diags *diag.Diagnostics) types.String {
+	if passwordID.IsNull() || passwordID.IsUnknown() {
+		return types.StringNull()
+	}
+
+	err := r.client.DeleteServiceAccountPassword(ctx, serviceAccountID, passwordID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		diags.AddWarning(
+			"Error deleting previous service_account_password",
+			"Could not delete service_account_password "+passwordID.ValueString()+": "+err.Error()+". It will be retried on the next apply.",
+		)
+		return passwordID
+	}
+	return types.StringNull()
+}
+
+// passwordExpiresAt returns created plus rotationDays, or nil when either is not set.
+func passwordExpiresAt(created types.String, rotationDays types.Int64) (*time.Time, error) {
+	if created.IsNull() || created.IsUnknown() || created.ValueString() == "" || rotationDays.IsNull() || rotationDays.IsUnknown() {
+		return nil, nil
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, created.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := createdAt.AddDate(0, 0, int(rotationDays.ValueInt64()))
+	return &expiresAt, nil
+}
+
+// overlapEnded reports whether the overlap window that started when the current password
+// was created has ended.
+func overlapEnded(created types.String, overlapHours types.Int64, now time.Time) bool {
+	createdAt, err := time.Parse(time.RFC3339, created.ValueString())
+	if err != nil {
+		// Without a usable timestamp, don't keep the previous password around forever.
+		return true
+	}
+	return !now.Before(createdAt.Add(time.Duration(overlapHours.ValueInt64()) * time.Hour))
 }
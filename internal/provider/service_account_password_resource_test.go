@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -228,3 +231,96 @@ resource "galaxy_service_account_password" "test" {
 }
 `, suffix)
 }
+
+// TestAccResourceServiceAccountPassword_RotateWhenChanged tests that changing rotate_when_changed
+// replaces the password in place and deletes the previous one.
+func TestAccResourceServiceAccountPassword_RotateWhenChanged(t *testing.T) {
+	suffix := testSuffix
+	passwordIDs := statecheck.CompareValue(compare.ValuesDiffer())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountPasswordConfigRotation(suffix, "1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					passwordIDs.AddStateValue("galaxy_service_account_password.test", tfjsonpath.New("service_account_password_id")),
+					statecheck.ExpectKnownValue(
+						"galaxy_service_account_password.test",
+						tfjsonpath.New("expires_at"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				Config: testAccServiceAccountPasswordConfigRotation(suffix, "2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					passwordIDs.AddStateValue("galaxy_service_account_password.test", tfjsonpath.New("service_account_password_id")),
+					statecheck.ExpectKnownValue(
+						"galaxy_service_account_password.test",
+						tfjsonpath.New("password"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^GXY\$`)),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_service_account_password.test",
+						tfjsonpath.New("previous_service_account_password_id"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+// testAccServiceAccountPasswordConfigRotation returns a password configuration with rotation settings
+func testAccServiceAccountPasswordConfigRotation(suffix, generation string) string {
+	return fmt.Sprintf(`
+resource "galaxy_service_account" "test" {
+  username              = "tfaccpwrot_%[1]s"
+  with_initial_password = false
+  additional_role_ids   = []
+}
+
+resource "galaxy_service_account_password" "test" {
+  service_account_id = galaxy_service_account.test.service_account_id
+  description        = "Rotating password"
+  rotation_days      = 90
+
+  rotate_when_changed = {
+    generation = "%[2]s"
+  }
+}
+`, suffix, generation)
+}
+
+func TestPasswordExpiresAt(t *testing.T) {
+	expiresAt, err := passwordExpiresAt(types.StringValue("2025-01-01T10:00:00.123Z"), types.Int64Value(90))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := expiresAt.Format(time.RFC3339), "2025-04-01T10:00:00Z"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+
+	if expiresAt, err := passwordExpiresAt(types.StringValue("2025-01-01T10:00:00Z"), types.Int64Null()); err != nil || expiresAt != nil {
+		t.Errorf("expected no expiry without rotation_days, got %v, %v", expiresAt, err)
+	}
+	if _, err := passwordExpiresAt(types.StringValue("yesterday"), types.Int64Value(1)); err == nil {
+		t.Error("expected an error for an unparseable created timestamp")
+	}
+}
+
+func TestOverlapEnded(t *testing.T) {
+	created := types.StringValue("2025-01-01T10:00:00Z")
+	now := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+
+	if overlapEnded(created, types.Int64Value(24), now) {
+		t.Error("expected overlap of 24h to still be running after 23h")
+	}
+	if !overlapEnded(created, types.Int64Value(23), now) {
+		t.Error("expected overlap of 23h to have ended after 23h")
+	}
+	if !overlapEnded(created, types.Int64Null(), now) {
+		t.Error("expected no overlap when rotation_overlap_hours is not set")
+	}
+}
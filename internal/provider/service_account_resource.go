@@ -93,10 +93,7 @@ func (r *service_accountResource) Create(ctx context.Context, req resource.Creat
 	tflog.Debug(ctx, "Creating service_account")
 	response, err := r.client.CreateServiceAccount(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating service_account",
-			"Could not create service_account: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating service_account", "Could not create service_account", err)
 		return
 	}
 
@@ -165,10 +162,7 @@ func (r *service_accountResource) Update(ctx context.Context, req resource.Updat
 	tflog.Debug(ctx, "Updating service_account", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateServiceAccount(ctx, id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating service_account",
-			"Could not update service_account "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating service_account", "Could not update service_account "+id, err)
 		return
 	}
 
@@ -109,10 +109,7 @@ func (r *snowflake_catalogResource) Create(ctx context.Context, req resource.Cre
 	tflog.Debug(ctx, "Creating snowflake_catalog")
 	response, err := r.client.CreateCatalog(ctx, "snowflake", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating snowflake_catalog",
-			"Could not create snowflake_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating snowflake_catalog", "Could not create snowflake_catalog", err)
 		return
 	}
 
@@ -209,10 +206,7 @@ func (r *snowflake_catalogResource) Update(ctx context.Context, req resource.Upd
 	tflog.Debug(ctx, "Updating snowflake_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "snowflake", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating snowflake_catalog",
-			"Could not update snowflake_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating snowflake_catalog", "Could not update snowflake_catalog "+id, err)
 		return
 	}
 
@@ -0,0 +1,190 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ datasource.DataSource = (*sqlJobHistoryDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*sqlJobHistoryDataSource)(nil)
+
+func NewSqlJobHistoryDataSource() datasource.DataSource {
+	return &sqlJobHistoryDataSource{}
+}
+
+type sqlJobHistoryDataSource struct {
+	client *client.GalaxyClient
+}
+
+type sqlJobHistoryModel struct {
+	SqlJobId      types.String `tfsdk:"sql_job_id"`
+	LastRunStatus types.String `tfsdk:"last_run_status"`
+	Result        types.List   `tfsdk:"result"`
+}
+
+// sqlJobRunAttrTypes describes one element of the galaxy_sql_job_history result list.
+var sqlJobRunAttrTypes = map[string]attr.Type{
+	"query_id":      types.StringType,
+	"status":        types.StringType,
+	"start_time":    types.StringType,
+	"end_time":      types.StringType,
+	"error_message": types.StringType,
+}
+
+func (d *sqlJobHistoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_job_history"
+}
+
+func (d *sqlJobHistoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"sql_job_id": schema.StringAttribute{
+				Required:    true,
+				Description: "SQL job ID",
+			},
+			"last_run_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the most recent run, null if the job has never run (read only)",
+			},
+			"result": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Recent runs of the SQL job (read only)",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"query_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the query executed by this run (read only)",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Run status (read only)",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time the run started (read only)",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time the run finished, null while running (read only)",
+						},
+						"error_message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Error reported by a failed run (read only)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sqlJobHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *sqlJobHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config sqlJobHistoryModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.SqlJobId.ValueString()
+
+	tflog.Debug(ctx, "Reading sql_job_history data source", map[string]interface{}{"id": id})
+	status, err := d.client.GetSqlJobStatus(ctx, id)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error reading sql_job status",
+			"Could not read status of sql_job "+id+": "+err.Error(),
+		)
+		return
+	}
+	if s, ok := status["status"].(string); ok {
+		config.LastRunStatus = types.StringValue(s)
+	} else {
+		config.LastRunStatus = types.StringNull()
+	}
+
+	response, err := d.client.GetSqlJobHistory(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading sql_job history",
+			"Could not read history of sql_job "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	elementType := types.ObjectType{AttrTypes: sqlJobRunAttrTypes}
+	runs := make([]attr.Value, 0)
+	if results, ok := response["result"].([]interface{}); ok {
+		for _, runInterface := range results {
+			runMap, ok := runInterface.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			attributes := map[string]attr.Value{}
+			for tfName, apiName := range map[string]string{
+				"query_id":      "queryId",
+				"status":        "status",
+				"start_time":    "startTime",
+				"end_time":      "endTime",
+				"error_message": "errorMessage",
+			} {
+				if v, ok := runMap[apiName].(string); ok {
+					attributes[tfName] = types.StringValue(v)
+				} else {
+					attributes[tfName] = types.StringNull()
+				}
+			}
+
+			run, diags := types.ObjectValue(sqlJobRunAttrTypes, attributes)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			runs = append(runs, run)
+		}
+	}
+
+	result, diags := types.ListValue(elementType, runs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Result = result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
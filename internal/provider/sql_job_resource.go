@@ -0,0 +1,342 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*sqlJobResource)(nil)
+var _ resource.ResourceWithConfigure = (*sqlJobResource)(nil)
+var _ resource.ResourceWithImportState = (*sqlJobResource)(nil)
+
+func NewSqlJobResource() resource.Resource {
+	return &sqlJobResource{}
+}
+
+type sqlJobResource struct {
+	client *client.GalaxyClient
+}
+
+type sqlJobModel struct {
+	SqlJobId       types.String `tfsdk:"sql_job_id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Query          types.String `tfsdk:"query"`
+	ClusterId      types.String `tfsdk:"cluster_id"`
+	RoleId         types.String `tfsdk:"role_id"`
+	CronExpression types.String `tfsdk:"cron_expression"`
+	Timezone       types.String `tfsdk:"timezone"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	NextExecution  types.String `tfsdk:"next_execution"`
+}
+
+func (r *sqlJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_job"
+}
+
+func (r *sqlJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a scheduled SQL job that runs a query on a cluster, as a role, on a cron schedule. Use the galaxy_sql_jobs and galaxy_sql_job_history data sources to inspect run status.",
+		Attributes: map[string]schema.Attribute{
+			"sql_job_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SQL job ID (read only)",
+				// sql_job_id is assigned at creation and never changes. Without UseStateForUnknown,
+				// any update marks it "known after apply", which propagates to galaxy_sql_job_history
+				// lookups that reference it.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "SQL job name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SQL job description",
+			},
+			"query": schema.StringAttribute{
+				Required:    true,
+				Description: "SQL statement executed on each run",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Cluster in which the query is executed",
+			},
+			"role_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Role used when the query is executed",
+			},
+			"cron_expression": schema.StringAttribute{
+				Required:    true,
+				Description: "Cron expression which determines execution interval",
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("UTC"),
+				Description: "Timezone in which CRON is evaluated",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Is the job schedule enabled",
+			},
+			"next_execution": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time of the next scheduled execution (read only)",
+			},
+		},
+	}
+}
+
+func (r *sqlJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *sqlJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sqlJobModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.modelToCreateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating sql_job", map[string]interface{}{"name": plan.Name.ValueString()})
+	response, err := r.client.CreateSqlJob(ctx, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating sql_job", "Could not create sql_job", err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Created sql_job", map[string]interface{}{"id": plan.SqlJobId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sqlJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sqlJobModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.SqlJobId.ValueString()
+	tflog.Debug(ctx, "Reading sql_job", map[string]interface{}{"id": id})
+	response, err := r.client.GetSqlJob(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "SQL job not found, removing from state", map[string]interface{}{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading sql_job",
+			"Could not read sql_job "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &state, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sqlJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sqlJobModel
+	var state sqlJobModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.SqlJobId.ValueString()
+	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating sql_job", map[string]interface{}{"id": id})
+	response, err := r.client.UpdateSqlJob(ctx, id, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating sql_job", "Could not update sql_job "+id, err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updated sql_job", map[string]interface{}{"id": plan.SqlJobId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sqlJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sqlJobModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.SqlJobId.ValueString()
+	tflog.Debug(ctx, "Deleting sql_job", map[string]interface{}{"id": id})
+	err := r.client.DeleteSqlJob(ctx, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting sql_job",
+				"Could not delete sql_job "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted sql_job", map[string]interface{}{"id": id})
+}
+
+func (r *sqlJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("sql_job_id"), req, resp)
+}
+
+// Helper methods
+func (r *sqlJobResource) modelToCreateRequest(ctx context.Context, model *sqlJobModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	// Required fields
+	request["name"] = model.Name.ValueString()
+	request["query"] = model.Query.ValueString()
+	request["clusterId"] = model.ClusterId.ValueString()
+	request["roleId"] = model.RoleId.ValueString()
+	request["cronExpression"] = model.CronExpression.ValueString()
+
+	// Optional fields
+	if !model.Description.IsNull() && !model.Description.IsUnknown() && model.Description.ValueString() != "" {
+		request["description"] = model.Description.ValueString()
+	}
+	if !model.Timezone.IsNull() && !model.Timezone.IsUnknown() {
+		request["timezone"] = model.Timezone.ValueString()
+	}
+	if !model.Enabled.IsNull() && !model.Enabled.IsUnknown() {
+		request["enabled"] = model.Enabled.ValueBool()
+	}
+
+	return request
+}
+
+func (r *sqlJobResource) modelToUpdateRequest(ctx context.Context, model *sqlJobModel, diags *diag.Diagnostics) map[string]interface{} {
+	// PATCH accepts the same fields as create, and sending the full set keeps the job in sync
+	// with the configuration even if it was edited in the Galaxy UI.
+	return r.modelToCreateRequest(ctx, model, diags)
+}
+
+func (r *sqlJobResource) updateModelFromResponse(ctx context.Context, model *sqlJobModel, response map[string]interface{}, diags *diag.Diagnostics) {
+	if id, ok := response["sqlJobId"].(string); ok {
+		model.SqlJobId = types.StringValue(id)
+	}
+
+	if name, ok := response["name"].(string); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if description, ok := response["description"].(string); ok {
+		model.Description = types.StringValue(description)
+	} else {
+		model.Description = types.StringNull()
+	}
+
+	if query, ok := response["query"].(string); ok {
+		model.Query = types.StringValue(query)
+	}
+
+	if clusterId, ok := response["clusterId"].(string); ok {
+		model.ClusterId = types.StringValue(clusterId)
+	}
+
+	if roleId, ok := response["roleId"].(string); ok {
+		model.RoleId = types.StringValue(roleId)
+	}
+
+	if cronExpression, ok := response["cronExpression"].(string); ok {
+		model.CronExpression = types.StringValue(cronExpression)
+	}
+
+	if timezone, ok := response["timezone"].(string); ok {
+		model.Timezone = types.StringValue(timezone)
+	}
+
+	if enabled, ok := response["enabled"].(bool); ok {
+		model.Enabled = types.BoolValue(enabled)
+	}
+
+	// next_execution is null while the job is disabled.
+	if nextExecution, ok := response["nextExecution"].(string); ok {
+		model.NextExecution = types.StringValue(nextExecution)
+	} else {
+		model.NextExecution = types.StringNull()
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccResourceSqlJob_Basic(t *testing.T) {
+	suffix := testSuffix
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSqlJobConfig(suffix, "0 3 * * *", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"galaxy_sql_job.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(fmt.Sprintf("sqljob-%s", suffix)),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_sql_job.test",
+						tfjsonpath.New("sql_job_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_sql_job.test",
+						tfjsonpath.New("timezone"),
+						knownvalue.StringExact("UTC"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.galaxy_sql_job_history.test",
+						tfjsonpath.New("result"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// Import testing
+			{
+				ResourceName:                         "galaxy_sql_job.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    importStateIdFunc("galaxy_sql_job.test", "sql_job_id"),
+				ImportStateVerifyIdentifierAttribute: "sql_job_id",
+			},
+			// Update and Read testing
+			{
+				Config: testAccSqlJobConfig(suffix, "30 4 * * 1", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"galaxy_sql_job.test",
+						tfjsonpath.New("cron_expression"),
+						knownvalue.StringExact("30 4 * * 1"),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_sql_job.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlJobs_List(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "galaxy_sql_jobs" "all" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.galaxy_sql_jobs.all",
+						tfjsonpath.New("result"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+// testAccSqlJobConfig returns a sql job configuration with its cluster, role and history lookup
+func testAccSqlJobConfig(suffix, cron string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "galaxy_cluster" "test" {
+  name                    = "sqljob-cluster-%[1]s"
+  cloud_region_id         = "aws-us-east1"
+  min_workers             = 1
+  max_workers             = 1
+  idle_stop_minutes       = 15
+  private_link_cluster    = false
+  result_cache_enabled    = false
+  warp_resiliency_enabled = false
+  catalog_refs            = []
+}
+
+resource "galaxy_role" "test" {
+  role_name              = "sqljobrole%[1]s"
+  role_description       = "Role for SQL job test"
+  grant_to_creating_role = true
+}
+
+resource "galaxy_sql_job" "test" {
+  name            = "sqljob-%[1]s"
+  query           = "SELECT 1"
+  cluster_id      = galaxy_cluster.test.cluster_id
+  role_id         = galaxy_role.test.role_id
+  cron_expression = %[2]q
+  enabled         = %[3]t
+}
+
+data "galaxy_sql_job_history" "test" {
+  sql_job_id = galaxy_sql_job.test.sql_job_id
+}
+`, suffix, cron, enabled)
+}
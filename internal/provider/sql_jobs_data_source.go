@@ -0,0 +1,241 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ datasource.DataSource = (*sqlJobsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*sqlJobsDataSource)(nil)
+
+func NewSqlJobsDataSource() datasource.DataSource {
+	return &sqlJobsDataSource{}
+}
+
+type sqlJobsDataSource struct {
+	client *client.GalaxyClient
+}
+
+type sqlJobsModel struct {
+	IncludeStatus types.Bool `tfsdk:"include_status"`
+	Result        types.List `tfsdk:"result"`
+}
+
+// sqlJobResultAttrTypes describes one element of the galaxy_sql_jobs result list.
+var sqlJobResultAttrTypes = map[string]attr.Type{
+	"sql_job_id":      types.StringType,
+	"name":            types.StringType,
+	"description":     types.StringType,
+	"query":           types.StringType,
+	"cluster_id":      types.StringType,
+	"role_id":         types.StringType,
+	"cron_expression": types.StringType,
+	"timezone":        types.StringType,
+	"enabled":         types.BoolType,
+	"next_execution":  types.StringType,
+	"last_run_status": types.StringType,
+	"last_run_time":   types.StringType,
+}
+
+func (d *sqlJobsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_jobs"
+}
+
+func (d *sqlJobsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"include_status": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Look up the most recent run of each job to fill in last_run_status and last_run_time. This costs one API call per job, which is slow under rate limiting for workspaces with many jobs. Defaults to false; galaxy_sql_job_history reads the status of a single job.",
+			},
+			"result": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A page of results.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sql_job_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "SQL job ID (read only)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "SQL job name (read only)",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "SQL job description (read only)",
+						},
+						"query": schema.StringAttribute{
+							Computed:    true,
+							Description: "SQL statement executed on each run (read only)",
+						},
+						"cluster_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cluster in which the query is executed (read only)",
+						},
+						"role_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Role used when the query is executed (read only)",
+						},
+						"cron_expression": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cron expression which determines execution interval (read only)",
+						},
+						"timezone": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timezone in which CRON is evaluated (read only)",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Is the job schedule enabled (read only)",
+						},
+						"next_execution": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time of the next scheduled execution (read only)",
+						},
+						"last_run_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the most recent run, null if the job has never run or include_status is not set (read only)",
+						},
+						"last_run_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Start time of the most recent run, null if the job has never run or include_status is not set (read only)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sqlJobsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *sqlJobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config sqlJobsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading sql jobs with automatic pagination")
+
+	allJobs, err := d.client.ListSqlJobs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading sql jobs",
+			"Could not read sql jobs: "+err.Error(),
+		)
+		return
+	}
+
+	elementType := types.ObjectType{AttrTypes: sqlJobResultAttrTypes}
+	jobs := make([]attr.Value, 0, len(allJobs))
+	for _, jobInterface := range allJobs {
+		jobMap, ok := jobInterface.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		attributes := d.mapSingleSqlJob(jobMap)
+
+		// The list endpoint does not include run status, so it is looked up per job when
+		// requested. A job that has never run has no status; that is reported as null. A failed
+		// lookup leaves the status null with a warning rather than failing the whole list.
+		attributes["last_run_status"] = types.StringNull()
+		attributes["last_run_time"] = types.StringNull()
+		if jobId, ok := jobMap["sqlJobId"].(string); ok && config.IncludeStatus.ValueBool() {
+			status, err := d.client.GetSqlJobStatus(ctx, jobId)
+			if err != nil && !client.IsNotFound(err) {
+				resp.Diagnostics.AddWarning(
+					"Error reading sql job status",
+					"Could not read status of sql job "+jobId+": "+err.Error(),
+				)
+			}
+			if s, ok := status["status"].(string); ok {
+				attributes["last_run_status"] = types.StringValue(s)
+			}
+			if t, ok := status["startTime"].(string); ok {
+				attributes["last_run_time"] = types.StringValue(t)
+			}
+		}
+
+		job, diags := types.ObjectValue(sqlJobResultAttrTypes, attributes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		jobs = append(jobs, job)
+	}
+
+	result, diags := types.ListValue(elementType, jobs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Result = result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *sqlJobsDataSource) mapSingleSqlJob(jobMap map[string]interface{}) map[string]attr.Value {
+	attributes := map[string]attr.Value{}
+
+	for tfName, apiName := range map[string]string{
+		"sql_job_id":      "sqlJobId",
+		"name":            "name",
+		"description":     "description",
+		"query":           "query",
+		"cluster_id":      "clusterId",
+		"role_id":         "roleId",
+		"cron_expression": "cronExpression",
+		"timezone":        "timezone",
+		"next_execution":  "nextExecution",
+	} {
+		if v, ok := jobMap[apiName].(string); ok {
+			attributes[tfName] = types.StringValue(v)
+		} else {
+			attributes[tfName] = types.StringNull()
+		}
+	}
+
+	if enabled, ok := jobMap["enabled"].(bool); ok {
+		attributes["enabled"] = types.BoolValue(enabled)
+	} else {
+		attributes["enabled"] = types.BoolNull()
+	}
+
+	return attributes
+}
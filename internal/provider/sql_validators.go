@@ -0,0 +1,110 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = sqlStatementValidator{}
+
+// sqlStatementKeywords lists the keywords a Trino SQL statement can start with.
+var sqlStatementKeywords = map[string]bool{
+	"ALTER": true, "ANALYZE": true, "CALL": true, "COMMENT": true, "CREATE": true,
+	"DEALLOCATE": true, "DELETE": true, "DENY": true, "DESC": true, "DESCRIBE": true,
+	"DROP": true, "EXECUTE": true, "EXPLAIN": true, "GRANT": true, "INSERT": true,
+	"MERGE": true, "PREPARE": true, "REFRESH": true, "RESET": true, "REVOKE": true,
+	"SELECT": true, "SET": true, "SHOW": true, "TABLE": true, "TRUNCATE": true,
+	"UPDATE": true, "USE": true, "VALUES": true, "WITH": true,
+}
+
+// sqlStatementValidator checks that a string contains a SQL statement: something other than
+// whitespace and comments, starting with a SQL keyword such as SELECT or WITH.
+type sqlStatementValidator struct{}
+
+func (v sqlStatementValidator) Description(ctx context.Context) string {
+	return "value must be a non-empty SQL statement"
+}
+
+func (v sqlStatementValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sqlStatementValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateSQLStatement(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SQL",
+			fmt.Sprintf("Value is not a valid SQL statement: %s.", err),
+		)
+	}
+}
+
+func validateSQLStatement(sql string) error {
+	statement := strings.TrimSpace(stripSQLComments(sql))
+	if statement == "" {
+		return fmt.Errorf("statement is empty")
+	}
+
+	// Parenthesized queries such as "(SELECT 1) UNION (SELECT 2)" start with the inner keyword.
+	statement = strings.TrimLeft(statement, "( \t\r\n")
+	keyword := statement
+	if end := strings.IndexFunc(statement, func(r rune) bool { return !unicode.IsLetter(r) }); end >= 0 {
+		keyword = statement[:end]
+	}
+	if !sqlStatementKeywords[strings.ToUpper(keyword)] {
+		return fmt.Errorf("statement must start with a SQL keyword such as SELECT or WITH")
+	}
+	return nil
+}
+
+// stripSQLComments removes "--" line comments and "/* */" block comments that are not inside
+// a quoted string or identifier.
+func stripSQLComments(sql string) string {
+	var b strings.Builder
+	var quote byte
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			b.WriteByte(c)
+		case c == '\'' || c == '"':
+			quote = c
+			b.WriteByte(c)
+		case strings.HasPrefix(sql[i:], "--"):
+			for i < len(sql) && sql[i] != '\n' {
+				i++
+			}
+			b.WriteByte('\n')
+		case strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				return b.String()
+			}
+			i += end + 3
+			b.WriteByte(' ')
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
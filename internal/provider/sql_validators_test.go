@@ -0,0 +1,47 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestValidateSQLStatement(t *testing.T) {
+	valid := []string{
+		"SELECT 1",
+		"select * from orders",
+		"  WITH t AS (SELECT 1) SELECT * FROM t",
+		"-- top customers\nSELECT name FROM customers",
+		"/* report */ SELECT count(*) FROM orders;",
+		"(SELECT 1) UNION (SELECT 2)",
+		"SHOW TABLES",
+	}
+	for _, sql := range valid {
+		if err := validateSQLStatement(sql); err != nil {
+			t.Errorf("validateSQLStatement(%q) returned error: %v", sql, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"   \n\t",
+		"-- only a comment",
+		"/* unterminated",
+		"/* block */",
+		"hello world",
+		"1 + 1",
+	}
+	for _, sql := range invalid {
+		if err := validateSQLStatement(sql); err == nil {
+			t.Errorf("validateSQLStatement(%q) expected an error", sql)
+		}
+	}
+}
+
+func TestStripSQLCommentsKeepsQuotedMarkers(t *testing.T) {
+	sql := "SELECT '--not a comment', \"/*col*/\" FROM t -- trailing"
+	got := stripSQLComments(sql)
+	want := "SELECT '--not a comment', \"/*col*/\" FROM t \n"
+	if got != want {
+		t.Errorf("stripSQLComments(%q) = %q, want %q", sql, got, want)
+	}
+}
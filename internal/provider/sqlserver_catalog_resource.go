@@ -130,10 +130,7 @@ func (r *sqlserver_catalogResource) Create(ctx context.Context, req resource.Cre
 	tflog.Debug(ctx, "Creating sqlserver_catalog")
 	response, err := r.client.CreateCatalog(ctx, "sqlserver", request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating sqlserver_catalog",
-			"Could not create sqlserver_catalog: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating sqlserver_catalog", "Could not create sqlserver_catalog", err)
 		return
 	}
 
@@ -210,10 +207,7 @@ func (r *sqlserver_catalogResource) Update(ctx context.Context, req resource.Upd
 	tflog.Debug(ctx, "Updating sqlserver_catalog", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateCatalog(ctx, "sqlserver", id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating sqlserver_catalog",
-			"Could not update sqlserver_catalog "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating sqlserver_catalog", "Could not update sqlserver_catalog "+id, err)
 		return
 	}
 
@@ -0,0 +1,121 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ datasource.DataSource = (*sshTunnelDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*sshTunnelDataSource)(nil)
+
+func NewSshTunnelDataSource() datasource.DataSource {
+	return &sshTunnelDataSource{}
+}
+
+type sshTunnelDataSource struct {
+	client *client.GalaxyClient
+}
+
+func (d *sshTunnelDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_tunnel"
+}
+
+func (d *sshTunnelDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ssh_tunnel_id": schema.StringAttribute{
+				Required:    true,
+				Description: "SSH tunnel ID",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "SSH tunnel name (read only)",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "SSH tunnel description (read only)",
+			},
+			"cloud_region_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Cloud region ID (read only)",
+			},
+			"ssh_tunnel_host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hostname or IP address of the bastion host (read only)",
+			},
+			"ssh_tunnel_port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "SSH port of the bastion host (read only)",
+			},
+			"ssh_tunnel_user": schema.StringAttribute{
+				Computed:    true,
+				Description: "User Galaxy connects to the bastion host as (read only)",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key generated by Galaxy for this tunnel (read only)",
+			},
+		},
+	}
+}
+
+func (d *sshTunnelDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *sshTunnelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config sshTunnelModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.SshTunnelId.ValueString()
+
+	tflog.Debug(ctx, "Reading ssh_tunnel data source", map[string]interface{}{"id": id})
+	response, err := d.client.GetSshTunnel(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ssh_tunnel",
+			"Could not read ssh_tunnel "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	// The data source exposes the same attributes as the resource, so reuse its mapping.
+	(&sshTunnelResource{}).updateModelFromResponse(ctx, &config, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,326 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*sshTunnelResource)(nil)
+var _ resource.ResourceWithConfigure = (*sshTunnelResource)(nil)
+var _ resource.ResourceWithImportState = (*sshTunnelResource)(nil)
+
+func NewSshTunnelResource() resource.Resource {
+	return &sshTunnelResource{}
+}
+
+type sshTunnelResource struct {
+	client *client.GalaxyClient
+}
+
+type sshTunnelModel struct {
+	SshTunnelId   types.String `tfsdk:"ssh_tunnel_id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	CloudRegionId types.String `tfsdk:"cloud_region_id"`
+	SshTunnelHost types.String `tfsdk:"ssh_tunnel_host"`
+	SshTunnelPort types.Int64  `tfsdk:"ssh_tunnel_port"`
+	SshTunnelUser types.String `tfsdk:"ssh_tunnel_user"`
+	PublicKey     types.String `tfsdk:"public_key"`
+}
+
+func (r *sshTunnelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_tunnel"
+}
+
+func (r *sshTunnelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an SSH tunnel that catalogs can use to reach data sources behind a bastion host. Galaxy generates a key pair for each tunnel; add the exported public_key to the authorized_keys of ssh_tunnel_user on the bastion host, then reference ssh_tunnel_id from a catalog's ssh_tunnel_id attribute.",
+		Attributes: map[string]schema.Attribute{
+			"ssh_tunnel_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "SSH tunnel ID (read only)",
+				// ssh_tunnel_id is assigned at creation and never changes. Without UseStateForUnknown,
+				// any update marks it "known after apply", which propagates to every catalog that
+				// references it and forces needless catalog updates.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "SSH tunnel name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SSH tunnel description",
+			},
+			"cloud_region_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Cloud region ID the tunnel is created in. Must match the region of the catalogs that use it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_tunnel_host": schema.StringAttribute{
+				Required:    true,
+				Description: "Hostname or IP address of the bastion host",
+			},
+			"ssh_tunnel_port": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(22),
+				Description: "SSH port of the bastion host. Defaults to 22.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"ssh_tunnel_user": schema.StringAttribute{
+				Required:    true,
+				Description: "User Galaxy connects to the bastion host as",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key generated by Galaxy for this tunnel. Add it to the authorized_keys of ssh_tunnel_user on the bastion host. (read only)",
+				// The key pair is generated once at creation and is not rotated by updates.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sshTunnelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *sshTunnelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sshTunnelModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.modelToCreateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating ssh_tunnel", map[string]interface{}{"name": plan.Name.ValueString()})
+	response, err := r.client.CreateSshTunnel(ctx, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating ssh_tunnel", "Could not create ssh_tunnel", err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Created ssh_tunnel", map[string]interface{}{"id": plan.SshTunnelId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshTunnelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sshTunnelModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.SshTunnelId.ValueString()
+	tflog.Debug(ctx, "Reading ssh_tunnel", map[string]interface{}{"id": id})
+	response, err := r.client.GetSshTunnel(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "SSH tunnel not found, removing from state", map[string]interface{}{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading ssh_tunnel",
+			"Could not read ssh_tunnel "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &state, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sshTunnelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sshTunnelModel
+	var state sshTunnelModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.SshTunnelId.ValueString()
+	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating ssh_tunnel", map[string]interface{}{"id": id})
+	response, err := r.client.UpdateSshTunnel(ctx, id, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating ssh_tunnel", "Could not update ssh_tunnel "+id, err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updated ssh_tunnel", map[string]interface{}{"id": plan.SshTunnelId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshTunnelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sshTunnelModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.SshTunnelId.ValueString()
+	tflog.Debug(ctx, "Deleting ssh_tunnel", map[string]interface{}{"id": id})
+	err := r.client.DeleteSshTunnel(ctx, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting ssh_tunnel",
+				"Could not delete ssh_tunnel "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted ssh_tunnel", map[string]interface{}{"id": id})
+}
+
+func (r *sshTunnelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ssh_tunnel_id"), req, resp)
+}
+
+// Helper methods
+func (r *sshTunnelResource) modelToCreateRequest(ctx context.Context, model *sshTunnelModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	// Required fields
+	request["name"] = model.Name.ValueString()
+	request["cloudRegionId"] = model.CloudRegionId.ValueString()
+	request["sshTunnelHost"] = model.SshTunnelHost.ValueString()
+	request["sshTunnelUser"] = model.SshTunnelUser.ValueString()
+	if !model.SshTunnelPort.IsNull() && !model.SshTunnelPort.IsUnknown() {
+		request["sshTunnelPort"] = model.SshTunnelPort.ValueInt64()
+	}
+
+	// Optional fields
+	if !model.Description.IsNull() && !model.Description.IsUnknown() && model.Description.ValueString() != "" {
+		request["description"] = model.Description.ValueString()
+	}
+
+	return request
+}
+
+func (r *sshTunnelResource) modelToUpdateRequest(ctx context.Context, model *sshTunnelModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := r.modelToCreateRequest(ctx, model, diags)
+
+	// cloudRegionId is immutable (RequiresReplace) and rejected by the PATCH endpoint.
+	delete(request, "cloudRegionId")
+
+	return request
+}
+
+func (r *sshTunnelResource) updateModelFromResponse(ctx context.Context, model *sshTunnelModel, response map[string]interface{}, diags *diag.Diagnostics) {
+	if id, ok := response["sshTunnelId"].(string); ok {
+		model.SshTunnelId = types.StringValue(id)
+	}
+
+	if name, ok := response["name"].(string); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if description, ok := response["description"].(string); ok {
+		model.Description = types.StringValue(description)
+	} else {
+		model.Description = types.StringNull()
+	}
+
+	if cloudRegionId, ok := response["cloudRegionId"].(string); ok {
+		model.CloudRegionId = types.StringValue(cloudRegionId)
+	}
+
+	if host, ok := response["sshTunnelHost"].(string); ok {
+		model.SshTunnelHost = types.StringValue(host)
+	}
+
+	if port, ok := response["sshTunnelPort"].(float64); ok {
+		model.SshTunnelPort = types.Int64Value(int64(port))
+	}
+
+	if user, ok := response["sshTunnelUser"].(string); ok {
+		model.SshTunnelUser = types.StringValue(user)
+	}
+
+	if publicKey, ok := response["publicKey"].(string); ok {
+		model.PublicKey = types.StringValue(publicKey)
+	} else if model.PublicKey.IsUnknown() {
+		model.PublicKey = types.StringNull()
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccResourceSshTunnel_Basic(t *testing.T) {
+	suffix := testSuffix
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSshTunnelConfigBasic(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"galaxy_ssh_tunnel.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(fmt.Sprintf("sshtunnel-%s", suffix)),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_ssh_tunnel.test",
+						tfjsonpath.New("ssh_tunnel_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_ssh_tunnel.test",
+						tfjsonpath.New("ssh_tunnel_port"),
+						knownvalue.Int64Exact(22),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_ssh_tunnel.test",
+						tfjsonpath.New("public_key"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.galaxy_ssh_tunnel.test",
+						tfjsonpath.New("ssh_tunnel_host"),
+						knownvalue.StringExact("bastion.example.com"),
+					),
+				},
+			},
+			// Import testing
+			{
+				ResourceName:                         "galaxy_ssh_tunnel.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    importStateIdFunc("galaxy_ssh_tunnel.test", "ssh_tunnel_id"),
+				ImportStateVerifyIdentifierAttribute: "ssh_tunnel_id",
+			},
+			// Update and Read testing
+			{
+				Config: testAccSshTunnelConfigUpdate(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"galaxy_ssh_tunnel.test",
+						tfjsonpath.New("ssh_tunnel_port"),
+						knownvalue.Int64Exact(2222),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_ssh_tunnel.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("Updated bastion tunnel"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSshTunnels_List(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "galaxy_ssh_tunnels" "all" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.galaxy_ssh_tunnels.all",
+						tfjsonpath.New("result"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+// testAccSshTunnelConfigBasic returns a basic ssh tunnel configuration with a data source lookup
+func testAccSshTunnelConfigBasic(suffix string) string {
+	return fmt.Sprintf(`
+resource "galaxy_ssh_tunnel" "test" {
+  name            = "sshtunnel-%[1]s"
+  cloud_region_id = "aws-us-east1"
+  ssh_tunnel_host = "bastion.example.com"
+  ssh_tunnel_user = "galaxy"
+}
+
+data "galaxy_ssh_tunnel" "test" {
+  ssh_tunnel_id = galaxy_ssh_tunnel.test.ssh_tunnel_id
+}
+`, suffix)
+}
+
+// testAccSshTunnelConfigUpdate returns an updated ssh tunnel configuration
+func testAccSshTunnelConfigUpdate(suffix string) string {
+	return fmt.Sprintf(`
+resource "galaxy_ssh_tunnel" "test" {
+  name            = "sshtunnel-%[1]s"
+  description     = "Updated bastion tunnel"
+  cloud_region_id = "aws-us-east1"
+  ssh_tunnel_host = "bastion.example.com"
+  ssh_tunnel_port = 2222
+  ssh_tunnel_user = "galaxy"
+}
+`, suffix)
+}
@@ -0,0 +1,190 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ datasource.DataSource = (*sshTunnelsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*sshTunnelsDataSource)(nil)
+
+func NewSshTunnelsDataSource() datasource.DataSource {
+	return &sshTunnelsDataSource{}
+}
+
+type sshTunnelsDataSource struct {
+	client *client.GalaxyClient
+}
+
+type sshTunnelsModel struct {
+	Result types.List `tfsdk:"result"`
+}
+
+// sshTunnelResultAttrTypes describes one element of the galaxy_ssh_tunnels result list.
+var sshTunnelResultAttrTypes = map[string]attr.Type{
+	"ssh_tunnel_id":   types.StringType,
+	"name":            types.StringType,
+	"description":     types.StringType,
+	"cloud_region_id": types.StringType,
+	"ssh_tunnel_host": types.StringType,
+	"ssh_tunnel_port": types.Int64Type,
+	"ssh_tunnel_user": types.StringType,
+	"public_key":      types.StringType,
+}
+
+func (d *sshTunnelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_tunnels"
+}
+
+func (d *sshTunnelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"result": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A page of results.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ssh_tunnel_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "SSH tunnel ID (read only)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "SSH tunnel name (read only)",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "SSH tunnel description (read only)",
+						},
+						"cloud_region_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud region ID (read only)",
+						},
+						"ssh_tunnel_host": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hostname or IP address of the bastion host (read only)",
+						},
+						"ssh_tunnel_port": schema.Int64Attribute{
+							Computed:    true,
+							Description: "SSH port of the bastion host (read only)",
+						},
+						"ssh_tunnel_user": schema.StringAttribute{
+							Computed:    true,
+							Description: "User Galaxy connects to the bastion host as (read only)",
+						},
+						"public_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Public key generated by Galaxy for this tunnel (read only)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sshTunnelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *sshTunnelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config sshTunnelsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading ssh tunnels with automatic pagination")
+
+	allTunnels, err := d.client.ListSshTunnels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ssh tunnels",
+			"Could not read ssh tunnels: "+err.Error(),
+		)
+		return
+	}
+
+	elementType := types.ObjectType{AttrTypes: sshTunnelResultAttrTypes}
+	tunnels := make([]attr.Value, 0, len(allTunnels))
+	for _, tunnelInterface := range allTunnels {
+		tunnelMap, ok := tunnelInterface.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		tunnel, diags := types.ObjectValue(sshTunnelResultAttrTypes, d.mapSingleSshTunnel(tunnelMap))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tunnels = append(tunnels, tunnel)
+	}
+
+	result, diags := types.ListValue(elementType, tunnels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Result = result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *sshTunnelsDataSource) mapSingleSshTunnel(tunnelMap map[string]interface{}) map[string]attr.Value {
+	attributes := map[string]attr.Value{}
+
+	for tfName, apiName := range map[string]string{
+		"ssh_tunnel_id":   "sshTunnelId",
+		"name":            "name",
+		"description":     "description",
+		"cloud_region_id": "cloudRegionId",
+		"ssh_tunnel_host": "sshTunnelHost",
+		"ssh_tunnel_user": "sshTunnelUser",
+		"public_key":      "publicKey",
+	} {
+		if v, ok := tunnelMap[apiName].(string); ok {
+			attributes[tfName] = types.StringValue(v)
+		} else {
+			attributes[tfName] = types.StringNull()
+		}
+	}
+
+	if port, ok := tunnelMap["sshTunnelPort"].(float64); ok {
+		attributes["ssh_tunnel_port"] = types.Int64Value(int64(port))
+	} else {
+		attributes["ssh_tunnel_port"] = types.Int64Null()
+	}
+
+	return attributes
+}
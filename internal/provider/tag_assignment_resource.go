@@ -0,0 +1,343 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*tagAssignmentResource)(nil)
+var _ resource.ResourceWithConfigure = (*tagAssignmentResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*tagAssignmentResource)(nil)
+var _ resource.ResourceWithImportState = (*tagAssignmentResource)(nil)
+
+func NewTagAssignmentResource() resource.Resource {
+	return &tagAssignmentResource{}
+}
+
+type tagAssignmentResource struct {
+	client *client.GalaxyClient
+}
+
+type tagAssignmentModel struct {
+	tagEntity
+	TagId   types.String `tfsdk:"tag_id"`
+	TagName types.String `tfsdk:"tag_name"`
+}
+
+func findTag(tags []map[string]interface{}, tagID string) (string, bool) {
+	for _, t := range tags {
+		if getStringFromMap(t, "tagId") == tagID {
+			return getStringFromMap(t, "name"), true
+		}
+	}
+	return "", false
+}
+
+func (r *tagAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_assignment"
+}
+
+func (r *tagAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := tagEntitySchemaAttributes()
+	attributes["tag_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The ID of the tag to assign.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["tag_name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The name of the assigned tag, populated from the API.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Assigns a single tag to a catalog, schema, table or column. This is a non-authoritative resource: other tags on the same entity are left untouched. Do not combine it with galaxy_entity_tags for the same entity, which owns the full tag set and removes tags it does not list.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *tagAssignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config tagAssignmentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.tagEntity.validate(&resp.Diagnostics)
+}
+
+func (r *tagAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *tagAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tagAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagPath := plan.tagPath()
+	tagID := plan.TagId.ValueString()
+
+	tflog.Debug(ctx, "Creating tag_assignment", map[string]interface{}{
+		"entity": tagPath,
+		"tagId":  tagID,
+	})
+
+	// Serialize read-modify-write to prevent concurrent updates from dropping tags
+	r.client.LockEntityTags(tagPath)
+
+	// Read current tags
+	tags, err := r.client.GetEntityTags(ctx, tagPath)
+	if err != nil {
+		r.client.UnlockEntityTags(tagPath)
+		resp.Diagnostics.AddError(
+			"Error reading entity tags",
+			"Could not read current tags of "+plan.Kind.ValueString()+" "+plan.tagEntity.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Check for duplicate
+	if _, found := findTag(tags, tagID); found {
+		r.client.UnlockEntityTags(tagPath)
+		resp.Diagnostics.AddError(
+			"Duplicate tag assignment",
+			fmt.Sprintf("Tag %s is already assigned to %s %s. Import it with: terraform import <address> %s/%s",
+				tagID, plan.Kind.ValueString(), plan.tagEntity.String(), tagID, plan.tagEntity.String()),
+		)
+		return
+	}
+
+	// PUT the full set with the new tag appended
+	err = r.client.UpdateEntityTags(ctx, tagPath, append(tagIDsFromTags(tags), tagID))
+	r.client.UnlockEntityTags(tagPath)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating tag assignment", "Could not update entity tags", err)
+		return
+	}
+
+	// Re-read to get the tag name
+	tags, err = r.client.GetEntityTags(ctx, tagPath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading entity tags after create",
+			"Could not verify tag assignment was created: "+err.Error(),
+		)
+		return
+	}
+	plan.TagName = types.StringNull()
+	if name, found := findTag(tags, tagID); found && name != "" {
+		plan.TagName = types.StringValue(name)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *tagAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tagAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagPath := state.tagPath()
+	tagID := state.TagId.ValueString()
+
+	tflog.Debug(ctx, "Reading tag_assignment", map[string]interface{}{
+		"entity": tagPath,
+		"tagId":  tagID,
+	})
+
+	tags, err := r.client.GetEntityTags(ctx, tagPath)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Entity not found, removing tag_assignment from state", map[string]interface{}{"entity": tagPath})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading entity tags",
+			"Could not read tags of "+state.Kind.ValueString()+" "+state.tagEntity.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	name, found := findTag(tags, tagID)
+	if !found {
+		tflog.Warn(ctx, "Tag assignment not found, removing from state", map[string]interface{}{
+			"entity": tagPath,
+			"tagId":  tagID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if name != "" {
+		state.TagName = types.StringValue(name)
+	} else {
+		state.TagName = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *tagAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes use RequiresReplace, so Update should never be called.
+	// If it is, just persist the plan.
+	var plan tagAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *tagAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tagAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagPath := state.tagPath()
+	tagID := state.TagId.ValueString()
+
+	tflog.Debug(ctx, "Deleting tag_assignment", map[string]interface{}{
+		"entity": tagPath,
+		"tagId":  tagID,
+	})
+
+	// Serialize read-modify-write to prevent concurrent updates from dropping tags
+	r.client.LockEntityTags(tagPath)
+
+	// Read current tags
+	tags, err := r.client.GetEntityTags(ctx, tagPath)
+	if err != nil {
+		r.client.UnlockEntityTags(tagPath)
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading entity tags",
+			"Could not read current tags of "+state.Kind.ValueString()+" "+state.tagEntity.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	if _, found := findTag(tags, tagID); !found {
+		r.client.UnlockEntityTags(tagPath)
+		return
+	}
+
+	// Filter out the tag being removed
+	remaining := make([]string, 0, len(tags))
+	for _, id := range tagIDsFromTags(tags) {
+		if id != tagID {
+			remaining = append(remaining, id)
+		}
+	}
+
+	// PUT the filtered set
+	err = r.client.UpdateEntityTags(ctx, tagPath, remaining)
+	r.client.UnlockEntityTags(tagPath)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Entity not found during tag_assignment delete; treating as already deleted", map[string]interface{}{
+				"entity": tagPath,
+				"tagId":  tagID,
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting tag assignment",
+			"Could not update entity tags: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted tag_assignment", map[string]interface{}{
+		"entity": tagPath,
+		"tagId":  tagID,
+	})
+}
+
+func (r *tagAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	entity, err := parseTagEntity(parts[1:])
+	if err != nil || parts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in the format tag_id/catalog_id[/schema_id[/table_id[/column_id]]]",
+		)
+		return
+	}
+
+	state := tagAssignmentModel{
+		tagEntity: entity,
+		TagId:     types.StringValue(parts[0]),
+		TagName:   types.StringNull(),
+	}
+
+	// Read from API to confirm the assignment and populate tag_name
+	tags, err := r.client.GetEntityTags(ctx, state.tagPath())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing tag assignment",
+			"Could not read tags of "+entity.Kind.ValueString()+" "+entity.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	name, found := findTag(tags, parts[0])
+	if !found {
+		resp.Diagnostics.AddError(
+			"Tag assignment not found",
+			fmt.Sprintf("Tag %s is not assigned to %s %s.", parts[0], entity.Kind.ValueString(), entity.String()),
+		)
+		return
+	}
+	if name != "" {
+		state.TagName = types.StringValue(name)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,173 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+// tagEntityKinds lists the entity kinds tags can be assigned to, ordered from the
+// top of the hierarchy down. The entity of kind tagEntityKinds[i] is identified by
+// the first i+1 entries of tagEntityIDAttributes.
+var tagEntityKinds = []string{"catalog", "schema", "table", "column"}
+
+var tagEntityIDAttributes = []string{"catalog_id", "schema_id", "table_id", "column_id"}
+
+// tagEntity identifies a catalog, schema, table or column that tags are assigned to.
+type tagEntity struct {
+	Kind      types.String `tfsdk:"entity_kind"`
+	CatalogId types.String `tfsdk:"catalog_id"`
+	SchemaId  types.String `tfsdk:"schema_id"`
+	TableId   types.String `tfsdk:"table_id"`
+	ColumnId  types.String `tfsdk:"column_id"`
+}
+
+// tagEntitySchemaAttributes returns the entity_kind and ID attributes shared by
+// galaxy_tag_assignment and galaxy_entity_tags. All of them force replacement.
+func tagEntitySchemaAttributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"entity_kind": schema.StringAttribute{
+			Required:    true,
+			Description: "Kind of entity the tags are assigned to: catalog, schema, table or column",
+			Validators: []validator.String{
+				stringvalidator.OneOf(tagEntityKinds...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+
+	descriptions := map[string]string{
+		"catalog_id": "Catalog ID. Required for every entity kind.",
+		"schema_id":  "Schema ID. Required when entity_kind is schema, table or column.",
+		"table_id":   "Table ID. Required when entity_kind is table or column.",
+		"column_id":  "Column ID. Required when entity_kind is column.",
+	}
+	for _, name := range tagEntityIDAttributes {
+		attributes[name] = schema.StringAttribute{
+			Required:    name == "catalog_id",
+			Optional:    name != "catalog_id",
+			Description: descriptions[name],
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	return attributes
+}
+
+func (e tagEntity) ids() []types.String {
+	return []types.String{e.CatalogId, e.SchemaId, e.TableId, e.ColumnId}
+}
+
+// validate checks that exactly the IDs needed for entity_kind are set.
+func (e tagEntity) validate(diags *diag.Diagnostics) {
+	if e.Kind.IsNull() || e.Kind.IsUnknown() {
+		return
+	}
+
+	depth := -1
+	for i, kind := range tagEntityKinds {
+		if kind == e.Kind.ValueString() {
+			depth = i
+		}
+	}
+	if depth < 0 {
+		return
+	}
+
+	for i, id := range e.ids() {
+		name := tagEntityIDAttributes[i]
+		switch {
+		case i <= depth && id.IsNull():
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing entity identifier",
+				fmt.Sprintf("%s is required when entity_kind is %s.", name, e.Kind.ValueString()),
+			)
+		case i > depth && !id.IsNull():
+			diags.AddAttributeError(
+				path.Root(name),
+				"Unexpected entity identifier",
+				fmt.Sprintf("%s cannot be set when entity_kind is %s.", name, e.Kind.ValueString()),
+			)
+		}
+	}
+}
+
+// tagPath returns the API path of the entity's tag collection.
+func (e tagEntity) tagPath() string {
+	return client.EntityTagPath(e.CatalogId.ValueString(), e.SchemaId.ValueString(), e.TableId.ValueString(), e.ColumnId.ValueString())
+}
+
+// String returns the entity's IDs joined by "/", the format used in import IDs.
+func (e tagEntity) String() string {
+	parts := make([]string, 0, len(tagEntityIDAttributes))
+	for _, id := range e.ids() {
+		if !id.IsNull() {
+			parts = append(parts, id.ValueString())
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// parseTagEntity builds an entity from 1 to 4 IDs (catalog_id[/schema_id[/table_id[/column_id]]]),
+// inferring entity_kind from how many are given.
+func parseTagEntity(parts []string) (tagEntity, error) {
+	if len(parts) < 1 || len(parts) > len(tagEntityIDAttributes) {
+		return tagEntity{}, fmt.Errorf("expected 1 to %d IDs, got %d", len(tagEntityIDAttributes), len(parts))
+	}
+
+	ids := make([]types.String, len(tagEntityIDAttributes))
+	for i := range ids {
+		ids[i] = types.StringNull()
+		if i < len(parts) {
+			if parts[i] == "" {
+				return tagEntity{}, fmt.Errorf("%s is empty", tagEntityIDAttributes[i])
+			}
+			ids[i] = types.StringValue(parts[i])
+		}
+	}
+
+	return tagEntity{
+		Kind:      types.StringValue(tagEntityKinds[len(parts)-1]),
+		CatalogId: ids[0],
+		SchemaId:  ids[1],
+		TableId:   ids[2],
+		ColumnId:  ids[3],
+	}, nil
+}
+
+// tagIDsFromTags extracts the tag IDs from a GetEntityTags response.
+func tagIDsFromTags(tags []map[string]interface{}) []string {
+	ids := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if id := getStringFromMap(t, "tagId"); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
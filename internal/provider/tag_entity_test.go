@@ -0,0 +1,68 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestTagAssignmentValidateConfigEntityIdentifiers(t *testing.T) {
+	cases := []struct {
+		name      string
+		kind      string
+		ids       map[string]interface{}
+		wantError bool
+	}{
+		{"catalog", "catalog", map[string]interface{}{"catalog_id": "c"}, false},
+		{"catalog with schema", "catalog", map[string]interface{}{"catalog_id": "c", "schema_id": "s"}, true},
+		{"table", "table", map[string]interface{}{"catalog_id": "c", "schema_id": "s", "table_id": "t"}, false},
+		{"table without schema", "table", map[string]interface{}{"catalog_id": "c", "table_id": "t"}, true},
+		{"column", "column", map[string]interface{}{"catalog_id": "c", "schema_id": "s", "table_id": "t", "column_id": "col"}, false},
+		{"column without column_id", "column", map[string]interface{}{"catalog_id": "c", "schema_id": "s", "table_id": "t"}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &tagAssignmentResource{}
+			overrides := map[string]interface{}{"tag_id": "tag-1", "entity_kind": tc.kind}
+			for name, id := range tc.ids {
+				overrides[name] = id
+			}
+			req := validateConfigRequest(t, resourceSchema(t, r), overrides)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("expected error=%t, got diagnostics: %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestParseTagEntity(t *testing.T) {
+	entity, err := parseTagEntity([]string{"c", "s", "t"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entity.Kind.ValueString() != "table" {
+		t.Errorf("expected kind table, got %s", entity.Kind.ValueString())
+	}
+	if !entity.ColumnId.IsNull() {
+		t.Errorf("expected null column_id, got %s", entity.ColumnId)
+	}
+	if got := entity.String(); got != "c/s/t" {
+		t.Errorf("expected c/s/t, got %s", got)
+	}
+	if got, want := entity.tagPath(), "/public/api/v1/catalog/c/schema/s/table/t/tag"; got != want {
+		t.Errorf("expected tag path %s, got %s", want, got)
+	}
+
+	for _, parts := range [][]string{{}, {"c", ""}, {"c", "s", "t", "col", "extra"}} {
+		if _, err := parseTagEntity(parts); err == nil {
+			t.Errorf("parseTagEntity(%q) expected an error", parts)
+		}
+	}
+}
@@ -92,10 +92,7 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	tflog.Debug(ctx, "Creating tag")
 	response, err := r.client.CreateTag(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating tag",
-			"Could not create tag: "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating tag", "Could not create tag", err)
 		return
 	}
 
@@ -164,10 +161,7 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	tflog.Debug(ctx, "Updating tag", map[string]interface{}{"id": id})
 	response, err := r.client.UpdateTag(ctx, id, request)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating tag",
-			"Could not update tag "+id+": "+err.Error(),
-		)
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating tag", "Could not update tag "+id, err)
 		return
 	}
 
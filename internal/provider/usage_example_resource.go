@@ -0,0 +1,325 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*usageExampleResource)(nil)
+var _ resource.ResourceWithConfigure = (*usageExampleResource)(nil)
+var _ resource.ResourceWithImportState = (*usageExampleResource)(nil)
+
+func NewUsageExampleResource() resource.Resource {
+	return &usageExampleResource{}
+}
+
+type usageExampleResource struct {
+	client *client.GalaxyClient
+}
+
+type usageExampleModel struct {
+	UsageExampleId  types.String `tfsdk:"usage_example_id"`
+	DataProductId   types.String `tfsdk:"data_product_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Code            types.String `tfsdk:"code"`
+	SuggestedPrompt types.String `tfsdk:"suggested_prompt"`
+	CreatedOn       types.String `tfsdk:"created_on"`
+	ModifiedOn      types.String `tfsdk:"modified_on"`
+}
+
+func (r *usageExampleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_example"
+}
+
+func (r *usageExampleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a usage example attached to a data product: a named SQL query showing consumers how to use the product.",
+		Attributes: map[string]schema.Attribute{
+			"usage_example_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Usage Example ID (read only)",
+				// usage_example_id is assigned at creation and never changes. Without
+				// UseStateForUnknown, any update marks it "known after apply".
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_product_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the owning Data Product",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Usage Example name",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Optional description of the usage example",
+			},
+			"code": schema.StringAttribute{
+				Required:    true,
+				Description: "SQL code for the usage example",
+				Validators: []validator.String{
+					sqlStatementValidator{},
+				},
+			},
+			"suggested_prompt": schema.StringAttribute{
+				Optional:    true,
+				Description: "Optional suggested natural-language prompt for the usage example",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date (read only)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_on": schema.StringAttribute{
+				Computed:    true,
+				Description: "Modified date (read only)",
+			},
+		},
+	}
+}
+
+func (r *usageExampleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *usageExampleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan usageExampleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataProductID := plan.DataProductId.ValueString()
+	request := r.modelToRequest(&plan)
+
+	tflog.Debug(ctx, "Creating usage_example", map[string]interface{}{"data_product_id": dataProductID, "name": plan.Name.ValueString()})
+	response, err := r.client.CreateUsageExample(ctx, dataProductID, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating usage_example", "Could not create usage_example", err)
+		return
+	}
+
+	r.updateModelFromResponse(&plan, response)
+
+	tflog.Debug(ctx, "Created usage_example", map[string]interface{}{"id": plan.UsageExampleId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *usageExampleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state usageExampleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataProductID := state.DataProductId.ValueString()
+	id := state.UsageExampleId.ValueString()
+	tflog.Debug(ctx, "Reading usage_example", map[string]interface{}{"data_product_id": dataProductID, "id": id})
+	response, err := r.client.GetUsageExample(ctx, dataProductID, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Usage example not found, removing from state", map[string]interface{}{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading usage_example",
+			"Could not read usage_example "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(&state, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *usageExampleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan usageExampleModel
+	var state usageExampleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataProductID := state.DataProductId.ValueString()
+	id := state.UsageExampleId.ValueString()
+	request := r.modelToRequest(&plan)
+
+	// Clearing suggested_prompt must be sent explicitly, otherwise PATCH keeps the old prompt.
+	if plan.SuggestedPrompt.IsNull() && !state.SuggestedPrompt.IsNull() {
+		request["suggestedPrompt"] = ""
+	}
+
+	tflog.Debug(ctx, "Updating usage_example", map[string]interface{}{"data_product_id": dataProductID, "id": id})
+	response, err := r.client.UpdateUsageExample(ctx, dataProductID, id, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating usage_example", "Could not update usage_example "+id, err)
+		return
+	}
+
+	r.updateModelFromResponse(&plan, response)
+
+	tflog.Debug(ctx, "Updated usage_example", map[string]interface{}{"id": plan.UsageExampleId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *usageExampleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state usageExampleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataProductID := state.DataProductId.ValueString()
+	id := state.UsageExampleId.ValueString()
+	tflog.Debug(ctx, "Deleting usage_example", map[string]interface{}{"data_product_id": dataProductID, "id": id})
+	err := r.client.DeleteUsageExample(ctx, dataProductID, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting usage_example",
+				"Could not delete usage_example "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted usage_example", map[string]interface{}{"id": id})
+}
+
+func (r *usageExampleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in the format data_product_id/usage_example_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_product_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("usage_example_id"), parts[1])...)
+}
+
+// Helper methods
+func (r *usageExampleResource) modelToRequest(model *usageExampleModel) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	// Required fields
+	request["name"] = model.Name.ValueString()
+	request["code"] = model.Code.ValueString()
+
+	// Optional fields
+	if !model.Description.IsNull() && !model.Description.IsUnknown() && model.Description.ValueString() != "" {
+		request["description"] = model.Description.ValueString()
+	}
+	if !model.SuggestedPrompt.IsNull() && !model.SuggestedPrompt.IsUnknown() {
+		request["suggestedPrompt"] = model.SuggestedPrompt.ValueString()
+	}
+
+	return request
+}
+
+func (r *usageExampleResource) updateModelFromResponse(model *usageExampleModel, response map[string]interface{}) {
+	if id, ok := response["usageExampleId"].(string); ok {
+		model.UsageExampleId = types.StringValue(id)
+	}
+
+	if dataProductID, ok := response["dataProductId"].(string); ok {
+		model.DataProductId = types.StringValue(dataProductID)
+	}
+
+	if name, ok := response["name"].(string); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if description, ok := response["description"].(string); ok {
+		model.Description = types.StringValue(description)
+	} else {
+		model.Description = types.StringNull()
+	}
+
+	if code, ok := response["code"].(string); ok {
+		model.Code = types.StringValue(code)
+	}
+
+	// suggested_prompt is Optional without Computed; an empty prompt from the API means unset.
+	if prompt, ok := response["suggestedPrompt"].(string); ok && prompt != "" {
+		model.SuggestedPrompt = types.StringValue(prompt)
+	} else {
+		model.SuggestedPrompt = types.StringNull()
+	}
+
+	if createdOn, ok := response["createdOn"].(string); ok {
+		model.CreatedOn = types.StringValue(createdOn)
+	} else if model.CreatedOn.IsUnknown() {
+		model.CreatedOn = types.StringNull()
+	}
+
+	if modifiedOn, ok := response["modifiedOn"].(string); ok {
+		model.ModifiedOn = types.StringValue(modifiedOn)
+	} else if model.ModifiedOn.IsUnknown() {
+		model.ModifiedOn = types.StringNull()
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright Starburst Data, Inc. All rights reserved.
+//
+// The source code is the proprietary and confidential information of Starburst Data, Inc. and
+// may be used only for reference purposes in connection with the Terraform Registry. All rights,
+// title, interest and ownership of the code and any derivatives, updates, upgrades, enhancements
+// and modifications thereof remain with Starburst Data, Inc. You are not permitted to distribute,
+// disclose, sell, lease, transfer, assign, modify, create derivative works of, or sublicense the
+// code, or use the code to create or develop any products or services.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/starburstdata/terraform-provider-galaxy/internal/client"
+)
+
+var _ resource.Resource = (*userResource)(nil)
+var _ resource.ResourceWithConfigure = (*userResource)(nil)
+var _ resource.ResourceWithImportState = (*userResource)(nil)
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+type userResource struct {
+	client *client.GalaxyClient
+}
+
+type userModel struct {
+	UserId                 types.String `tfsdk:"user_id"`
+	Email                  types.String `tfsdk:"email"`
+	DefaultRoleId          types.String `tfsdk:"default_role_id"`
+	DirectlyGrantedRoleIds types.List   `tfsdk:"directly_granted_role_ids"`
+	DeactivateOnDestroy    types.Bool   `tfsdk:"deactivate_on_destroy"`
+	ScimManaged            types.Bool   `tfsdk:"scim_managed"`
+	CreatedOn              types.String `tfsdk:"created_on"`
+}
+
+func (r *userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Invites and manages a Galaxy user. Creating the resource sends an invitation to the email address. When directly_granted_role_ids is set, it is authoritative for the user's directly granted roles; leave it unset to manage grants elsewhere (for example in the Galaxy UI).",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "User ID (read only)",
+				// user_id is assigned at creation and never changes. Without UseStateForUnknown,
+				// any update marks it "known after apply", which propagates to policies and grants
+				// that reference the user.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "Email address the invitation is sent to. Changing it invites a new user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_role_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Role the user assumes when signing in. Defaults to the account's default role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"directly_granted_role_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "IDs of roles granted directly to the user. Roles granted outside Terraform are revoked when this is set.",
+			},
+			"deactivate_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Deactivate the user instead of deleting it on destroy, keeping its query history and ownership intact",
+			},
+			"scim_managed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "SCIM managed (read only)",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date (read only)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.GalaxyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.GalaxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := r.modelToCreateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating user", map[string]interface{}{"email": plan.Email.ValueString()})
+	response, err := r.client.CreateUser(ctx, request)
+	if err != nil {
+		addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error creating user", "Could not create user", err)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Created user", map[string]interface{}{"id": plan.UserId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.UserId.ValueString()
+	tflog.Debug(ctx, "Reading user", map[string]interface{}{"id": id})
+	response, err := r.client.GetUser(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "User not found, removing from state", map[string]interface{}{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			"Could not read user "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &state, response, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userModel
+	var state userModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.UserId.ValueString()
+	request := r.modelToUpdateRequest(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// deactivate_on_destroy is provider-side only; skip the API call when nothing else changed.
+	if len(request) > 0 {
+		tflog.Debug(ctx, "Updating user", map[string]interface{}{"id": id})
+		response, err := r.client.UpdateUser(ctx, id, request)
+		if err != nil {
+			addAPIErrorDiagnostic(ctx, &resp.Diagnostics, resp.State, "Error updating user", "Could not update user "+id, err)
+			return
+		}
+
+		r.updateModelFromResponse(ctx, &plan, response, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.UserId = state.UserId
+		plan.DefaultRoleId = state.DefaultRoleId
+		plan.ScimManaged = state.ScimManaged
+		plan.CreatedOn = state.CreatedOn
+	}
+
+	tflog.Debug(ctx, "Updated user", map[string]interface{}{"id": plan.UserId.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.UserId.ValueString()
+
+	if state.DeactivateOnDestroy.ValueBool() {
+		tflog.Debug(ctx, "Deactivating user", map[string]interface{}{"id": id})
+		_, err := r.client.UpdateUser(ctx, id, map[string]interface{}{"active": false})
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deactivating user",
+				"Could not deactivate user "+id+": "+err.Error(),
+			)
+			return
+		}
+
+		tflog.Debug(ctx, "Deactivated user", map[string]interface{}{"id": id})
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting user", map[string]interface{}{"id": id})
+	err := r.client.DeleteUser(ctx, id)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting user",
+				"Could not delete user "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted user", map[string]interface{}{"id": id})
+}
+
+// ImportState accepts either a user ID or an email address. Email addresses are resolved to
+// the user ID so that state is always keyed by ID.
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !strings.Contains(req.ID, "@") {
+		resource.ImportStatePassthroughID(ctx, path.Root("user_id"), req, resp)
+		return
+	}
+
+	response, err := r.client.GetUser(ctx, "email="+req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing user",
+			"Could not find user with email "+req.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	userID, ok := response["userId"].(string)
+	if !ok || userID == "" {
+		resp.Diagnostics.AddError(
+			"Error importing user",
+			"User lookup for email "+req.ID+" returned no userId.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
+
+// Helper methods
+func (r *userResource) modelToCreateRequest(ctx context.Context, model *userModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := make(map[string]interface{})
+
+	// Required fields
+	request["email"] = model.Email.ValueString()
+
+	// Optional fields
+	if !model.DefaultRoleId.IsNull() && !model.DefaultRoleId.IsUnknown() && model.DefaultRoleId.ValueString() != "" {
+		request["defaultRoleId"] = model.DefaultRoleId.ValueString()
+	}
+
+	roleIDs, d := stringListElements(ctx, model.DirectlyGrantedRoleIds)
+	diags.Append(d...)
+	if roleIDs != nil {
+		grants := make([]map[string]interface{}, 0, len(roleIDs))
+		for _, roleID := range roleIDs {
+			grants = append(grants, map[string]interface{}{"roleId": roleID})
+		}
+		request["directlyGrantedRoles"] = grants
+	}
+
+	return request
+}
+
+func (r *userResource) modelToUpdateRequest(ctx context.Context, model *userModel, diags *diag.Diagnostics) map[string]interface{} {
+	request := r.modelToCreateRequest(ctx, model, diags)
+
+	// email is immutable (RequiresReplace) and rejected by the PATCH endpoint.
+	delete(request, "email")
+
+	return request
+}
+
+func (r *userResource) updateModelFromResponse(ctx context.Context, model *userModel, response map[string]interface{}, diags *diag.Diagnostics) {
+	if userId, ok := response["userId"].(string); ok {
+		model.UserId = types.StringValue(userId)
+	}
+
+	if email, ok := response["email"].(string); ok {
+		model.Email = types.StringValue(email)
+	}
+
+	if defaultRoleId, ok := response["defaultRoleId"].(string); ok {
+		model.DefaultRoleId = types.StringValue(defaultRoleId)
+	} else if model.DefaultRoleId.IsUnknown() {
+		model.DefaultRoleId = types.StringNull()
+	}
+
+	if scimManaged, ok := response["scimManaged"].(bool); ok {
+		model.ScimManaged = types.BoolValue(scimManaged)
+	} else {
+		model.ScimManaged = types.BoolNull()
+	}
+
+	if createdOn, ok := response["createdOn"].(string); ok {
+		model.CreatedOn = types.StringValue(createdOn)
+	} else if model.CreatedOn.IsUnknown() {
+		model.CreatedOn = types.StringNull()
+	}
+
+	// Imported resources have no deactivate_on_destroy value yet; use the schema default.
+	if model.DeactivateOnDestroy.IsNull() || model.DeactivateOnDestroy.IsUnknown() {
+		model.DeactivateOnDestroy = types.BoolValue(false)
+	}
+
+	// directly_granted_role_ids is Optional without Computed: leave it null when the user does
+	// not manage grants, so grants made outside Terraform do not produce a diff.
+	if model.DirectlyGrantedRoleIds.IsNull() {
+		return
+	}
+
+	actual := make([]string, 0)
+	if grants, ok := response["directlyGrantedRoles"].([]interface{}); ok {
+		for _, g := range grants {
+			if gMap, ok := g.(map[string]interface{}); ok {
+				if roleID := getStringFromMap(gMap, "roleId"); roleID != "" {
+					actual = append(actual, roleID)
+				}
+			}
+		}
+	}
+
+	planned, d := stringListElements(ctx, model.DirectlyGrantedRoleIds)
+	diags.Append(d...)
+	actual = reorderToMatchPlan(planned, actual)
+
+	listValue, d := types.ListValueFrom(ctx, types.StringType, actual)
+	diags.Append(d...)
+	if !d.HasError() {
+		model.DirectlyGrantedRoleIds = listValue
+	}
+}
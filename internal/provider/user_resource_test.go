@@ -0,0 +1,108 @@
+// Copyright (c) Starburst Data, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccResourceUser_Basic(t *testing.T) {
+	suffix := testSuffix
+	email := fmt.Sprintf("tf-user-%s@example.com", suffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserConfigBasic(suffix, email),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"galaxy_user.test",
+						tfjsonpath.New("email"),
+						knownvalue.StringExact(email),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_user.test",
+						tfjsonpath.New("user_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"galaxy_user.test",
+						tfjsonpath.New("directly_granted_role_ids"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+			// Import by user ID
+			{
+				ResourceName:                         "galaxy_user.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    importStateIdFunc("galaxy_user.test", "user_id"),
+				ImportStateVerifyIdentifierAttribute: "user_id",
+				ImportStateVerifyIgnore:              []string{"directly_granted_role_ids"},
+			},
+			// Import by email
+			{
+				ResourceName:                         "galaxy_user.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    func(*terraform.State) (string, error) { return email, nil },
+				ImportStateVerifyIdentifierAttribute: "user_id",
+				ImportStateVerifyIgnore:              []string{"directly_granted_role_ids"},
+			},
+			// Update and Read testing - revoke the directly granted role
+			{
+				Config: testAccUserConfigNoGrants(suffix, email),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"galaxy_user.test",
+						tfjsonpath.New("directly_granted_role_ids"),
+						knownvalue.ListSizeExact(0),
+					),
+				},
+			},
+		},
+	})
+}
+
+// testAccUserConfigBasic returns a user configuration with one directly granted role
+func testAccUserConfigBasic(suffix, email string) string {
+	return fmt.Sprintf(`
+resource "galaxy_role" "test" {
+  role_name              = "userrole%[1]s"
+  role_description       = "Role for user test"
+  grant_to_creating_role = true
+}
+
+resource "galaxy_user" "test" {
+  email                     = %[2]q
+  directly_granted_role_ids = [galaxy_role.test.role_id]
+}
+`, suffix, email)
+}
+
+// testAccUserConfigNoGrants returns a user configuration that revokes all directly granted roles
+func testAccUserConfigNoGrants(suffix, email string) string {
+	return fmt.Sprintf(`
+resource "galaxy_role" "test" {
+  role_name              = "userrole%[1]s"
+  role_description       = "Role for user test"
+  grant_to_creating_role = true
+}
+
+resource "galaxy_user" "test" {
+  email                     = %[2]q
+  directly_granted_role_ids = []
+}
+`, suffix, email)
+}